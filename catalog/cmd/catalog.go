@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"reflect"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/kubeflow/model-registry/catalog/internal/catalog"
 	"github.com/kubeflow/model-registry/catalog/internal/db/models"
 	"github.com/kubeflow/model-registry/catalog/internal/db/service"
+	"github.com/kubeflow/model-registry/catalog/internal/server/middleware"
 	"github.com/kubeflow/model-registry/catalog/internal/server/openapi"
 	"github.com/kubeflow/model-registry/internal/datastore"
 	"github.com/kubeflow/model-registry/internal/datastore/embedmd"
@@ -21,10 +23,26 @@ var catalogCfg = struct {
 	ListenAddress          string
 	ConfigPath             []string
 	PerformanceMetricsPath []string
+	CacheControl           middleware.CacheControlConfig
+	MaxPageSize            int32
+	StaleSourceThreshold   time.Duration
+	SourceHTTPTimeout      time.Duration
+	SourceTypeHTTPTimeouts map[string]string
+	SourceProxy            string
+	SourceNoProxy          string
+	SourceCABundle         string
 }{
 	ListenAddress:          "0.0.0.0:8080",
 	ConfigPath:             []string{"sources.yaml"},
 	PerformanceMetricsPath: []string{},
+	CacheControl:           middleware.DefaultCacheControlConfig(),
+	MaxPageSize:            0,
+	StaleSourceThreshold:   0,
+	SourceHTTPTimeout:      30 * time.Second,
+	SourceTypeHTTPTimeouts: map[string]string{},
+	SourceProxy:            os.Getenv("CATALOG_SOURCE_PROXY"),
+	SourceNoProxy:          catalog.NoProxyFromEnvironment(),
+	SourceCABundle:         os.Getenv("CATALOG_SOURCE_CA_BUNDLE"),
 }
 
 var CatalogCmd = &cobra.Command{
@@ -42,9 +60,27 @@ func init() {
 	fs.StringVarP(&catalogCfg.ListenAddress, "listen", "l", catalogCfg.ListenAddress, "Address to listen on")
 	fs.StringSliceVar(&catalogCfg.ConfigPath, "catalogs-path", catalogCfg.ConfigPath, "Path to catalog source configuration file")
 	fs.StringSliceVar(&catalogCfg.PerformanceMetricsPath, "performance-metrics", catalogCfg.PerformanceMetricsPath, "Path to performance metrics data directory")
+	fs.DurationVar(&catalogCfg.SourceHTTPTimeout, "source-http-timeout", catalogCfg.SourceHTTPTimeout, "Default timeout for HTTP requests made by catalog sources")
+	fs.StringToStringVar(&catalogCfg.SourceTypeHTTPTimeouts, "source-type-http-timeout", catalogCfg.SourceTypeHTTPTimeouts, "Per source type HTTP request timeouts, e.g. hf=2m")
+	fs.StringVar(&catalogCfg.SourceProxy, "source-proxy", catalogCfg.SourceProxy, "HTTP proxy URL for outbound source requests, overriding HTTP_PROXY/HTTPS_PROXY (defaults to $CATALOG_SOURCE_PROXY)")
+	fs.StringVar(&catalogCfg.SourceNoProxy, "source-no-proxy", catalogCfg.SourceNoProxy, "Comma-separated hosts that bypass --source-proxy (defaults to $NO_PROXY or $no_proxy)")
+	fs.StringVar(&catalogCfg.SourceCABundle, "source-ca-bundle", catalogCfg.SourceCABundle, "Comma-separated PEM CA bundles added to the system pool for outbound source TLS (defaults to $CATALOG_SOURCE_CA_BUNDLE)")
+	fs.Int32Var(&catalogCfg.MaxPageSize, "max-page-size", catalogCfg.MaxPageSize, "Maximum pageSize returned by list endpoints; larger requests are clamped (0, the default, for no limit)")
+	fs.DurationVar(&catalogCfg.StaleSourceThreshold, "stale-source-threshold", catalogCfg.StaleSourceThreshold, "Report periodically synced sources whose last sync is older than this as stale (0, the default, to disable)")
+	fs.DurationVar(&catalogCfg.CacheControl.Discovery, "cache-max-age-discovery", catalogCfg.CacheControl.Discovery, "Cache-Control max-age for the sources and labels endpoints (0 to disable)")
+	fs.DurationVar(&catalogCfg.CacheControl.FilterOptions, "cache-max-age-filter-options", catalogCfg.CacheControl.FilterOptions, "Cache-Control max-age for the filter options endpoint (0 to disable)")
+	fs.DurationVar(&catalogCfg.CacheControl.Models, "cache-max-age-models", catalogCfg.CacheControl.Models, "Cache-Control max-age for model and artifact reads (0 to disable)")
 }
 
 func runCatalogServer(cmd *cobra.Command, args []string) error {
+	httpClientConfig, err := sourceHTTPClientConfig()
+	if err != nil {
+		return err
+	}
+	if err := catalog.SetHTTPClientConfig(httpClientConfig); err != nil {
+		return err
+	}
+
 	ds, err := datastore.NewConnector("embedmd", &embedmd.EmbedMDConfig{
 		DatabaseType: "postgres", // We only support postgres right now
 		DatabaseDSN:  "",         // Empty DSN, see https://www.postgresql.org/docs/current/libpq-envars.html
@@ -93,9 +129,35 @@ func runCatalogServer(cmd *cobra.Command, args []string) error {
 		services.CatalogSourceRepository,
 	)
 	ctrl := openapi.NewModelCatalogServiceAPIController(svc)
+	openapi.SetMaxPageSize(catalogCfg.MaxPageSize)
+	openapi.SetStaleSourceThreshold(catalogCfg.StaleSourceThreshold)
 
 	glog.Infof("Catalog API server listening on %s", catalogCfg.ListenAddress)
-	return http.ListenAndServe(catalogCfg.ListenAddress, openapi.NewRouter(ctrl))
+	return http.ListenAndServe(catalogCfg.ListenAddress, middleware.Singleflight(middleware.PrettyJSON(middleware.CacheControl(openapi.NewRouter(ctrl), catalogCfg.CacheControl))))
+}
+
+func sourceHTTPClientConfig() (catalog.HTTPClientConfig, error) {
+	cfg := catalog.HTTPClientConfig{
+		DefaultTimeout: catalogCfg.SourceHTTPTimeout,
+		Timeouts:       make(map[string]time.Duration, len(catalogCfg.SourceTypeHTTPTimeouts)),
+		Proxy:          catalogCfg.SourceProxy,
+		NoProxy:        catalogCfg.SourceNoProxy,
+	}
+
+	rootCAs, err := catalog.LoadCABundle(catalogCfg.SourceCABundle)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.RootCAs = rootCAs
+
+	for sourceType, value := range catalogCfg.SourceTypeHTTPTimeouts {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid HTTP timeout for source type %s: %w", sourceType, err)
+		}
+		cfg.Timeouts[sourceType] = timeout
+	}
+	return cfg, nil
 }
 
 func getRepo[T any](repoSet datastore.RepoSet) T {
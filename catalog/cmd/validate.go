@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kubeflow/model-registry/catalog/internal/catalog"
+	"github.com/spf13/cobra"
+)
+
+var validateCfg = struct {
+	Files []string
+}{
+	Files: []string{},
+}
+
+var ValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate catalog source configuration files",
+	Long: `Validate catalog source configuration files offline, without
+	starting the server or contacting any source. Each file is checked
+	for YAML syntax, unknown fields and source semantics, and properties
+	that look like plain text credentials are reported as warnings. The
+	command exits with an error if any file is invalid. Log output of
+	the loader is discarded unless -v is set.`,
+	Args: cobra.NoArgs,
+	RunE: runValidate,
+}
+
+func init() {
+	fs := ValidateCmd.Flags()
+	fs.StringSliceVarP(&validateCfg.Files, "file", "f", validateCfg.Files, "Path to catalog source configuration file to validate")
+	_ = ValidateCmd.MarkFlagRequired("file")
+
+	CatalogCmd.AddCommand(ValidateCmd)
+}
+
+func runValidate(cmd *cobra.Command, args []string) error {
+	if v := cmd.Flag("v"); v == nil || !v.Changed {
+		restore, err := discardLogs()
+		if err != nil {
+			return err
+		}
+		defer restore()
+	}
+
+	invalid := 0
+	for _, path := range validateCfg.Files {
+		result, err := catalog.ValidateSourceConfigFile(path)
+		if err != nil {
+			return err
+		}
+		printValidationResult(cmd.OutOrStdout(), result)
+		if !result.Valid() {
+			invalid++
+		}
+	}
+
+	if invalid > 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("%d of %d configuration files failed validation", invalid, len(validateCfg.Files))
+	}
+	return nil
+}
+
+// discardLogs sends log output to the null device until restore is called,
+// so loader logs do not mix into the validation report. glog has no way to
+// change its output, but it writes to whatever os.Stderr is at the time.
+func discardLogs() (restore func(), err error) {
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	stderr := os.Stderr
+	os.Stderr = devNull
+	return func() {
+		os.Stderr = stderr
+		_ = devNull.Close()
+	}, nil
+}
+
+func printValidationResult(w io.Writer, result *catalog.ConfigValidationResult) {
+	fmt.Fprintf(w, "%s:\n", result.Path)
+	for _, layer := range result.Layers {
+		status := "PASS"
+		switch {
+		case layer.Skipped:
+			status = "SKIP"
+		case !layer.Passed:
+			status = "FAIL"
+		}
+		fmt.Fprintf(w, "  %-14s %s\n", layer.Name, status)
+		for _, err := range layer.Errors {
+			fmt.Fprintf(w, "    - %s\n", strings.TrimSpace(err))
+		}
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(w, "  WARNING: %s\n", warning)
+	}
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDiscardsLogs(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "models.yaml"), []byte("models: []\n"), 0644))
+	path := filepath.Join(dir, "sources.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+catalogs:
+  - name: Local
+    id: local
+    type: yaml
+    properties:
+      yamlCatalogPath: models.yaml
+`), 0644))
+
+	// Log to stderr, as the model-registry command does, and capture it.
+	require.NoError(t, flag.Set("logtostderr", "true"))
+	t.Cleanup(func() { _ = flag.Set("logtostderr", "false") })
+
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	stderr := os.Stderr
+	os.Stderr = w
+	t.Cleanup(func() { os.Stderr = stderr })
+
+	files := validateCfg.Files
+	validateCfg.Files = []string{path}
+	t.Cleanup(func() { validateCfg.Files = files })
+
+	var out bytes.Buffer
+	ValidateCmd.SetOut(&out)
+	t.Cleanup(func() { ValidateCmd.SetOut(nil) })
+
+	require.NoError(t, runValidate(ValidateCmd, nil))
+	require.NoError(t, w.Close())
+	logs, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	assert.Empty(t, string(logs))
+	assert.Contains(t, out.String(), "semantic       PASS")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -1027,6 +1028,15 @@ func (m *MockCatalogSourceRepository) GetBySourceID(sourceID string) (dbmodels.C
 func (m *MockCatalogSourceRepository) Save(source dbmodels.CatalogSource) (dbmodels.CatalogSource, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	// Replace an existing source with the same ID, like the real repository.
+	if attrs := source.GetAttributes(); attrs != nil && attrs.Name != nil {
+		for i, s := range m.Sources {
+			if existing := s.GetAttributes(); existing != nil && existing.Name != nil && *existing.Name == *attrs.Name {
+				m.Sources[i] = source
+				return source, nil
+			}
+		}
+	}
 	m.Sources = append(m.Sources, source)
 	return source, nil
 }
@@ -1065,6 +1075,14 @@ func (m *MockCatalogSourceRepository) GetAllStatuses() (map[string]dbmodels.Sour
 						if prop.StringValue != nil {
 							status.Error = *prop.StringValue
 						}
+					case "lastSyncTimeSinceEpoch":
+						if prop.StringValue != nil {
+							status.LastSyncTimeSinceEpoch, _ = strconv.ParseInt(*prop.StringValue, 10, 64)
+						}
+					case "notModified":
+						if prop.BoolValue != nil {
+							status.NotModified = *prop.BoolValue
+						}
 					}
 				}
 			}
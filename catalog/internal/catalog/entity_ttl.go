@@ -0,0 +1,166 @@
+package catalog
+
+import (
+	"fmt"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/golang/glog"
+	dbmodels "github.com/kubeflow/model-registry/catalog/internal/db/models"
+)
+
+// entityTTLKey is the source property that keeps models which are no longer
+// listed by the source until they have not been seen for the given duration.
+//
+// Expired models are deleted, the same way models that a source stops listing
+// are deleted without a TTL: catalog models have no tombstone or soft-deleted
+// state, and a model that is listed again is simply saved again.
+const entityTTLKey = "entityTTL"
+
+// periodicSyncIntervals are the default sync intervals of the source types
+// that fetch their models again periodically. Models are only expired when a
+// source reports its models, so entityTTL is only supported on these types.
+var periodicSyncIntervals = map[string]time.Duration{
+	"hf":  defaultSyncInterval,
+	"url": defaultURLSyncInterval,
+}
+
+// sourceSyncInterval returns how often source reports its models, and false if
+// it only does so when its configuration changes.
+func sourceSyncInterval(source *Source) (time.Duration, bool) {
+	interval, ok := periodicSyncIntervals[source.Type]
+	if !ok {
+		return 0, false
+	}
+	if raw, ok := source.Properties[syncIntervalKey].(string); ok && raw != "" {
+		if parsed, err := time.ParseDuration(raw); err == nil && parsed > 0 {
+			interval = parsed
+		}
+	}
+	return interval, true
+}
+
+// SyncsPeriodically reports whether the source fetches its models again on a
+// schedule, rather than only when its configuration changes.
+func (s *Source) SyncsPeriodically() bool {
+	_, ok := sourceSyncInterval(s)
+	return ok
+}
+
+// parseEntityTTL parses the entityTTL property of a source. It returns 0 if the
+// property is not set. The TTL must be longer than the longest time between
+// two syncs of the source, its sync interval plus its sync jitter, otherwise
+// models would expire between two syncs.
+func parseEntityTTL(source *Source) (time.Duration, error) {
+	value, ok := source.Properties[entityTTLKey]
+	if !ok {
+		return 0, nil
+	}
+	raw, ok := value.(string)
+	if !ok {
+		return 0, fmt.Errorf("invalid %s property: must be a duration string", entityTTLKey)
+	}
+	ttl, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s property: %w", entityTTLKey, err)
+	}
+	if ttl <= 0 {
+		return 0, fmt.Errorf("invalid %s property: must be positive", entityTTLKey)
+	}
+
+	interval, ok := sourceSyncInterval(source)
+	if !ok {
+		return 0, fmt.Errorf("invalid %s property: %q sources do not sync periodically", entityTTLKey, source.Type)
+	}
+	jitter, err := parseSyncJitter(source, interval)
+	if err != nil {
+		return 0, err
+	}
+	if ttl <= interval+jitter {
+		return 0, fmt.Errorf("invalid %s property: %v must be longer than the %v between two syncs (%s %v plus %s %v)", entityTTLKey, ttl, interval+jitter, syncIntervalKey, interval, syncJitterKey, jitter)
+	}
+	return ttl, nil
+}
+
+// markModelsSeen records that the named models were listed by a source at now.
+func (l *Loader) markModelsSeen(sourceID string, names mapset.Set[string], now time.Time) {
+	l.lastSeenMu.Lock()
+	defer l.lastSeenMu.Unlock()
+
+	seen := l.lastSeen[sourceID]
+	if seen == nil {
+		seen = map[string]time.Time{}
+		l.lastSeen[sourceID] = seen
+	}
+	for name := range names.Iter() {
+		seen[name] = now
+	}
+}
+
+// markSourceSeen records that every model previously listed by a source was
+// listed again at now, for sources that report an unchanged batch.
+func (l *Loader) markSourceSeen(sourceID string, now time.Time) {
+	l.lastSeenMu.Lock()
+	defer l.lastSeenMu.Unlock()
+
+	for name := range l.lastSeen[sourceID] {
+		l.lastSeen[sourceID][name] = now
+	}
+}
+
+// removeExpiredModelsFromSource removes the models of a source that have not
+// been seen for longer than ttl. Models that were never seen by this process,
+// e.g. after a restart, are given a full TTL from now.
+func (l *Loader) removeExpiredModelsFromSource(sourceID string, ttl time.Duration, now time.Time) (int, error) {
+	list, err := l.services.CatalogModelRepository.List(dbmodels.CatalogModelListOptions{
+		SourceIDs: &[]string{sourceID},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to list models from source %q: %w", sourceID, err)
+	}
+
+	expired := []dbmodels.CatalogModel{}
+	func() {
+		l.lastSeenMu.Lock()
+		defer l.lastSeenMu.Unlock()
+
+		seen := l.lastSeen[sourceID]
+		if seen == nil {
+			seen = map[string]time.Time{}
+			l.lastSeen[sourceID] = seen
+		}
+
+		for _, model := range list.Items {
+			attr := model.GetAttributes()
+			if attr == nil || attr.Name == nil || model.GetID() == nil {
+				continue
+			}
+
+			lastSeen, ok := seen[*attr.Name]
+			if !ok {
+				seen[*attr.Name] = now
+				continue
+			}
+			if now.Sub(lastSeen) <= ttl {
+				continue
+			}
+
+			delete(seen, *attr.Name)
+			expired = append(expired, model)
+		}
+	}()
+
+	count := 0
+	for _, model := range expired {
+		name := *model.GetAttributes().Name
+		glog.Infof("Removing %s model %s, not seen for more than %v", sourceID, name, ttl)
+
+		err = l.services.CatalogModelRepository.DeleteByID(*model.GetID())
+		if err != nil {
+			return count, fmt.Errorf("unable to remove model %d (%s from source %s): %w", *model.GetID(), name, sourceID, err)
+		}
+		count++
+	}
+
+	return count, nil
+}
@@ -0,0 +1,236 @@
+package catalog
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	dbmodels "github.com/kubeflow/model-registry/catalog/internal/db/models"
+	"github.com/kubeflow/model-registry/catalog/internal/db/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// MockCatalogModelRepositoryWithDeletes records the IDs passed to DeleteByID
+// and drops the matching models.
+type MockCatalogModelRepositoryWithDeletes struct {
+	MockCatalogModelRepository
+	deleteMu   sync.Mutex
+	DeletedIDs []int32
+}
+
+func (m *MockCatalogModelRepositoryWithDeletes) DeleteByID(id int32) error {
+	m.mu.Lock()
+	kept := m.SavedModels[:0]
+	for _, model := range m.SavedModels {
+		if *model.GetID() != id {
+			kept = append(kept, model)
+		}
+	}
+	m.SavedModels = kept
+	m.mu.Unlock()
+
+	m.deleteMu.Lock()
+	defer m.deleteMu.Unlock()
+	m.DeletedIDs = append(m.DeletedIDs, id)
+	return nil
+}
+
+func (m *MockCatalogModelRepositoryWithDeletes) deleted() []int32 {
+	m.deleteMu.Lock()
+	defer m.deleteMu.Unlock()
+	return append([]int32{}, m.DeletedIDs...)
+}
+
+func newEntityTTLLoader(t *testing.T, names ...string) (*Loader, *MockCatalogModelRepositoryWithDeletes) {
+	t.Helper()
+
+	repo := &MockCatalogModelRepositoryWithDeletes{}
+	for _, name := range names {
+		_, err := repo.Save(&dbmodels.CatalogModelImpl{
+			Attributes: &dbmodels.CatalogModelAttributes{Name: &name},
+		})
+		require.NoError(t, err)
+	}
+
+	services := service.NewServices(
+		repo,
+		&MockCatalogArtifactRepository{},
+		&MockCatalogModelArtifactRepository{},
+		&MockCatalogMetricsArtifactRepository{},
+		&MockCatalogSourceRepository{},
+		&MockPropertyOptionsRepository{},
+	)
+	return NewLoader(services, []string{}), repo
+}
+
+func TestParseEntityTTL(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sourceType string
+		properties map[string]any
+		expected   time.Duration
+		err        string
+	}{
+		{
+			name:       "not set",
+			sourceType: "yaml",
+			properties: map[string]any{},
+		},
+		{
+			name:       "longer than the default sync interval",
+			sourceType: "hf",
+			properties: map[string]any{entityTTLKey: "72h"},
+			expected:   72 * time.Hour,
+		},
+		{
+			name:       "longer than the configured sync interval",
+			sourceType: "url",
+			properties: map[string]any{entityTTLKey: "20m", syncIntervalKey: "10m"},
+			expected:   20 * time.Minute,
+		},
+		{
+			name:       "not a duration",
+			sourceType: "url",
+			properties: map[string]any{entityTTLKey: "soon"},
+			err:        "invalid entityTTL property",
+		},
+		{
+			name:       "not a string",
+			sourceType: "url",
+			properties: map[string]any{entityTTLKey: 3},
+			err:        "must be a duration string",
+		},
+		{
+			name:       "not positive",
+			sourceType: "url",
+			properties: map[string]any{entityTTLKey: "0s"},
+			err:        "must be positive",
+		},
+		{
+			name:       "source without periodic sync",
+			sourceType: "yaml",
+			properties: map[string]any{entityTTLKey: "72h"},
+			err:        `"yaml" sources do not sync periodically`,
+		},
+		{
+			name:       "shorter than the default sync interval",
+			sourceType: "hf",
+			properties: map[string]any{entityTTLKey: "1h"},
+			err:        "1h0m0s must be longer than the 26h24m0s between two syncs (syncInterval 24h0m0s plus syncJitter 2h24m0s)",
+		},
+		{
+			name:       "equal to the configured sync interval",
+			sourceType: "url",
+			properties: map[string]any{entityTTLKey: "2h", syncIntervalKey: "2h"},
+			err:        "must be longer than the 2h12m0s between two syncs",
+		},
+		{
+			name:       "shorter than the sync interval plus the configured jitter",
+			sourceType: "url",
+			properties: map[string]any{entityTTLKey: "2h", syncIntervalKey: "1h", syncJitterKey: "1h"},
+			err:        "2h0m0s must be longer than the 2h0m0s between two syncs",
+		},
+		{
+			name:       "longer than the sync interval without jitter",
+			sourceType: "url",
+			properties: map[string]any{entityTTLKey: "61m", syncIntervalKey: "1h", syncJitterKey: "0s"},
+			expected:   61 * time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ttl, err := parseEntityTTL(&Source{Type: tc.sourceType, Properties: tc.properties})
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, ttl)
+		})
+	}
+}
+
+func TestLoaderRejectsInvalidEntityTTL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sources.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+catalogs:
+  - name: Local
+    id: local
+    type: yaml
+    properties:
+      yamlCatalogPath: models.yaml
+      entityTTL: 1h
+`), 0644))
+
+	sourceRepo := &MockCatalogSourceRepository{}
+	services := service.NewServices(
+		&MockCatalogModelRepository{},
+		&MockCatalogArtifactRepository{},
+		&MockCatalogModelArtifactRepository{},
+		&MockCatalogMetricsArtifactRepository{},
+		sourceRepo,
+		&MockPropertyOptionsRepository{},
+	)
+
+	loader := NewLoader(services, []string{path})
+	require.NoError(t, loader.parseAndMerge(path))
+
+	for range loader.readProviderRecords(t.Context()) {
+	}
+
+	statuses, err := sourceRepo.GetAllStatuses()
+	require.NoError(t, err)
+	assert.Equal(t, SourceStatusError, statuses["local"].Status)
+	assert.Contains(t, statuses["local"].Error, `"yaml" sources do not sync periodically`)
+}
+
+func TestRemoveExpiredModelsFromSource(t *testing.T) {
+	loader, repo := newEntityTTLLoader(t, "listed", "unlisted")
+	ttl := time.Hour
+	start := time.Now()
+
+	// Only "listed" is in the batch; "unlisted" has never been seen and gets a
+	// full TTL from the first sweep.
+	loader.markModelsSeen("src", mapset.NewSet("listed"), start)
+	count, err := loader.removeExpiredModelsFromSource("src", ttl, start)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	// "listed" is seen again; "unlisted" is not refreshed within the TTL.
+	loader.markModelsSeen("src", mapset.NewSet("listed"), start.Add(50*time.Minute))
+	count, err = loader.removeExpiredModelsFromSource("src", ttl, start.Add(61*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []int32{2}, repo.deleted())
+
+	// Nothing else expires until "listed" is older than the TTL.
+	count, err = loader.removeExpiredModelsFromSource("src", ttl, start.Add(110*time.Minute))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	count, err = loader.removeExpiredModelsFromSource("src", ttl, start.Add(111*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, []int32{2, 1}, repo.deleted())
+}
+
+func TestMarkSourceSeen(t *testing.T) {
+	loader, repo := newEntityTTLLoader(t, "listed")
+	ttl := time.Hour
+	start := time.Now()
+
+	loader.markModelsSeen("src", mapset.NewSet("listed"), start)
+
+	// A not modified batch counts as listing the same models again.
+	loader.markSourceSeen("src", start.Add(50*time.Minute))
+	count, err := loader.removeExpiredModelsFromSource("src", ttl, start.Add(90*time.Minute))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.Empty(t, repo.deleted())
+}
@@ -2,13 +2,16 @@ package catalog
 
 import (
 	"context"
+	"crypto/sha256"
 	_ "embed"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -104,6 +107,14 @@ type hfModelProvider struct {
 	// syncInterval is the interval for periodic syncing of models.
 	// This can be configured via the syncInterval property in the source configuration.
 	syncInterval time.Duration
+	// syncJitter is the largest random delay added to each syncInterval.
+	syncJitter time.Duration
+	// maintenanceWindows are the windows during which periodic syncs are
+	// postponed.
+	maintenanceWindows []maintenanceWindow
+	// contentHash identifies the upstream content of the last batch that was
+	// emitted without errors. A periodic sync with the same hash is skipped.
+	contentHash string
 }
 
 // hfModelInfo represents the structure of Hugging Face API model information
@@ -400,7 +411,7 @@ func (hfm *hfModel) populateFromHFInfo(ctx context.Context, provider *hfModelPro
 
 func (p *hfModelProvider) Models(ctx context.Context) (<-chan ModelProviderRecord, error) {
 	// Read the catalog - may return partial results with an error if any models fail to be loaded
-	catalog, fetchErr := p.getModelsFromHF(ctx)
+	catalog, contentHash, fetchErr := p.getModelsFromHF(ctx)
 
 	// If we got no models AND an error, return the error immediately
 	if fetchErr != nil && len(catalog) == 0 {
@@ -413,21 +424,39 @@ func (p *hfModelProvider) Models(ctx context.Context) (<-chan ModelProviderRecor
 
 		// Send the initial list right away, then send error status if there was a partial failure
 		p.emitWithError(ctx, catalog, fetchErr, ch)
+		if fetchErr == nil {
+			p.contentHash = contentHash
+		}
 
-		// Set up periodic polling with configurable interval
-		ticker := time.NewTicker(p.syncInterval)
-		defer ticker.Stop()
+		// Set up periodic polling with configurable interval and jitter
+		timer := time.NewTimer(nextSyncTimerDelay(time.Now(), p.syncInterval, p.syncJitter, p.maintenanceWindows))
+		defer timer.Stop()
 
 		for {
 			select {
 			case <-ctx.Done():
 				return
-			case <-ticker.C:
+			case <-timer.C:
+				timer.Reset(nextSyncTimerDelay(time.Now(), p.syncInterval, p.syncJitter, p.maintenanceWindows))
 				glog.Infof("Periodic sync: reprocessing all models for source %s", p.sourceId)
-				catalog, err := p.getModelsFromHF(ctx)
+				catalog, contentHash, err := p.getModelsFromHF(ctx)
+				if err == nil && contentHash == p.contentHash {
+					glog.Infof("Periodic sync: models for source %s not modified, skipping", p.sourceId)
+					select {
+					case ch <- ModelProviderRecord{NotModified: true}:
+					case <-ctx.Done():
+					}
+					continue
+				}
+
 				// Even if there's an error, emit successful models first, then signal the error
 				if len(catalog) > 0 || err == nil {
 					p.emitWithError(ctx, catalog, err, ch)
+					if err != nil {
+						// After a partial failure, the next sync is always emitted.
+						contentHash = ""
+					}
+					p.contentHash = contentHash
 				} else {
 					// No models and an error - just log it
 					glog.Errorf("unable to reprocess Hugging Face models: %v", err)
@@ -493,14 +522,18 @@ func (p *hfModelProvider) expandModelNames(ctx context.Context, modelIdentifiers
 	return allNames, nil
 }
 
-func (p *hfModelProvider) getModelsFromHF(ctx context.Context) ([]ModelProviderRecord, error) {
+// getModelsFromHF fetches the models of the source. It also returns a hash of
+// the upstream revision of every fetched model, which changes whenever a model
+// is added, removed or updated.
+func (p *hfModelProvider) getModelsFromHF(ctx context.Context) ([]ModelProviderRecord, string, error) {
 	// First expand any wildcard patterns to concrete model names
 	expandedModels, err := p.expandModelNames(ctx, p.includedModels)
 	if err != nil {
-		return nil, fmt.Errorf("failed to expand model patterns: %w", err)
+		return nil, "", fmt.Errorf("failed to expand model patterns: %w", err)
 	}
 
 	var records []ModelProviderRecord
+	revisions := []string{}
 	currentTime := time.Now().UnixMilli()
 	lastSyncedStr := strconv.FormatInt(currentTime, 10)
 
@@ -521,6 +554,7 @@ func (p *hfModelProvider) getModelsFromHF(ctx context.Context) ([]ModelProviderR
 		}
 
 		record := p.convertHFModelToRecord(ctx, modelInfo, modelName)
+		revisions = append(revisions, strings.Join([]string{modelName, modelInfo.ID, modelInfo.Sha, modelInfo.UpdatedAt}, "\x00"))
 
 		// Additional safety check: verify the final model name is not excluded
 		// (in case the model name changed during conversion, e.g., from hfInfo.ID)
@@ -532,7 +566,9 @@ func (p *hfModelProvider) getModelsFromHF(ctx context.Context) ([]ModelProviderR
 			}
 		}
 
-		// Add last_synced property to the model
+		// Add last_synced property to the model. Syncs that find no changes
+		// write nothing, so this is the last sync that changed the source;
+		// the loader reports every sync in the source status.
 		if record.Model != nil {
 			if modelImpl, ok := record.Model.(*dbmodels.CatalogModelImpl); ok {
 				customProps := modelImpl.CustomProperties
@@ -548,11 +584,15 @@ func (p *hfModelProvider) getModelsFromHF(ctx context.Context) ([]ModelProviderR
 		records = append(records, record)
 	}
 
+	slices.Sort(revisions)
+	sum := sha256.Sum256([]byte(strings.Join(revisions, "\n")))
+	contentHash := hex.EncodeToString(sum[:])
+
 	if len(failedModels) > 0 {
-		return records, &PartiallyAvailableError{FailedModels: failedModels}
+		return records, contentHash, &PartiallyAvailableError{FailedModels: failedModels}
 	}
 
-	return records, nil
+	return records, contentHash, nil
 }
 
 func (p *hfModelProvider) fetchModelInfo(ctx context.Context, modelName string) (*hfModelInfo, error) {
@@ -851,7 +891,12 @@ func (p *hfModelProvider) validateCredentials(ctx context.Context) error {
 
 func newHFModelProvider(ctx context.Context, source *Source, reldir string) (<-chan ModelProviderRecord, error) {
 	p := &hfModelProvider{}
-	p.client = &http.Client{Timeout: 30 * time.Second}
+
+	client, err := newSourceHTTPClient("hf", source.Properties, reldir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Hugging Face catalog source %s: %w", source.GetId(), err)
+	}
+	p.client = client
 
 	// Parse Source ID
 	sourceId := source.GetId()
@@ -894,6 +939,17 @@ func newHFModelProvider(ctx context.Context, source *Source, reldir string) (<-c
 		}
 	}
 
+	syncJitter, err := parseSyncJitter(source, p.syncInterval)
+	if err != nil {
+		return nil, err
+	}
+	p.syncJitter = syncJitter
+
+	p.maintenanceWindows, err = parseMaintenanceWindows(source)
+	if err != nil {
+		return nil, err
+	}
+
 	if p.apiKey != "" {
 		hasValidPrefix := strings.HasPrefix(p.apiKey, "hf_")
 		if !hasValidPrefix {
@@ -938,8 +994,13 @@ func init() {
 // NewHFPreviewProvider creates an hfModelProvider for preview use.
 // It initializes the provider from a PreviewConfig without starting the full model loading.
 func NewHFPreviewProvider(config *PreviewConfig) (*hfModelProvider, error) {
+	client, err := newSourceHTTPClient("hf", config.Properties, "")
+	if err != nil {
+		return nil, err
+	}
+
 	p := &hfModelProvider{
-		client:       &http.Client{Timeout: 30 * time.Second},
+		client:       client,
 		baseURL:      defaultHuggingFaceURL,
 		maxModels:    defaultMaxModels,
 		syncInterval: defaultSyncInterval,
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -1664,3 +1665,72 @@ func TestClassifyModelTypeFromTasks(t *testing.T) {
 		})
 	}
 }
+
+func TestHfModelProvider_Models_SkipsUnchangedSync(t *testing.T) {
+	var mu sync.Mutex
+	sha := "rev-1"
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/models/test-org/model-1" {
+			http.Error(w, "Not found", http.StatusNotFound)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		json.NewEncoder(w).Encode(hfModelInfo{ID: "test-org/model-1", Author: "test-org", Sha: sha})
+	}))
+	defer mockServer.Close()
+
+	filter, err := NewModelFilter([]string{}, []string{})
+	require.NoError(t, err)
+
+	provider := &hfModelProvider{
+		baseURL:        mockServer.URL,
+		includedModels: []string{"test-org/model-1"},
+		filter:         filter,
+		client:         &http.Client{},
+		syncInterval:   20 * time.Millisecond,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := provider.Models(ctx)
+	require.NoError(t, err)
+
+	next := func() ModelProviderRecord {
+		t.Helper()
+		select {
+		case record := <-ch:
+			return record
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for record")
+			return ModelProviderRecord{}
+		}
+	}
+
+	// Initial batch: one model and the end-of-batch marker.
+	assert.NotNil(t, next().Model)
+	marker := next()
+	assert.Nil(t, marker.Model)
+	assert.False(t, marker.NotModified)
+
+	// Unchanged upstream content is reported as not modified.
+	for range 2 {
+		record := next()
+		assert.Nil(t, record.Model)
+		assert.True(t, record.NotModified)
+	}
+
+	// A new revision is emitted again.
+	mu.Lock()
+	sha = "rev-2"
+	mu.Unlock()
+
+	record := next()
+	for record.NotModified {
+		record = next()
+	}
+	require.NotNil(t, record.Model)
+	assert.Equal(t, "test-org/model-1", *record.Model.GetAttributes().Name)
+	assert.False(t, next().NotModified)
+}
@@ -0,0 +1,205 @@
+package catalog
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+const (
+	// defaultHTTPTimeout is the request timeout used by source HTTP clients
+	// when nothing else is configured.
+	defaultHTTPTimeout = 30 * time.Second
+
+	// clientCertPathKey and clientKeyPathKey are the source properties that
+	// point at a PEM client certificate and key (usually mounted from a
+	// Secret) to present to the upstream for mutual TLS.
+	clientCertPathKey = "clientCertPath"
+	clientKeyPathKey  = "clientKeyPath"
+)
+
+// HTTPClientConfig controls the HTTP clients that catalog sources use to reach
+// their upstreams.
+type HTTPClientConfig struct {
+	// DefaultTimeout is the request timeout for source types without an
+	// entry in Timeouts.
+	DefaultTimeout time.Duration
+
+	// Timeouts overrides DefaultTimeout per source type (e.g. "hf").
+	Timeouts map[string]time.Duration
+
+	// Proxy is the URL of an HTTP proxy used for all outbound source
+	// requests. When empty, the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+	// environment variables are honored.
+	Proxy string
+
+	// NoProxy is a comma-separated list of hosts that bypass Proxy, using
+	// the same syntax as the NO_PROXY environment variable. It is only used
+	// when Proxy is set.
+	NoProxy string
+
+	// RootCAs is the certificate pool used to verify upstream servers. When
+	// nil, the system pool is used. See LoadCABundle.
+	RootCAs *x509.CertPool
+}
+
+var (
+	httpClientConfigMu sync.RWMutex
+	httpClientConfig   = HTTPClientConfig{DefaultTimeout: defaultHTTPTimeout}
+)
+
+// SetHTTPClientConfig replaces the configuration used for HTTP clients
+// created by sources. It should be called before the loader is started.
+func SetHTTPClientConfig(cfg HTTPClientConfig) error {
+	if cfg.Proxy != "" {
+		if _, err := url.Parse(cfg.Proxy); err != nil {
+			return fmt.Errorf("invalid source proxy URL: %w", err)
+		}
+	}
+
+	httpClientConfigMu.Lock()
+	defer httpClientConfigMu.Unlock()
+	httpClientConfig = cfg
+	return nil
+}
+
+func getHTTPClientConfig() HTTPClientConfig {
+	httpClientConfigMu.RLock()
+	defer httpClientConfigMu.RUnlock()
+	return httpClientConfig
+}
+
+// Timeout returns the request timeout for the given source type.
+func (c HTTPClientConfig) Timeout(sourceType string) time.Duration {
+	if timeout, ok := c.Timeouts[sourceType]; ok && timeout > 0 {
+		return timeout
+	}
+	if c.DefaultTimeout > 0 {
+		return c.DefaultTimeout
+	}
+	return defaultHTTPTimeout
+}
+
+// proxyFunc returns the function used by the transport to select a proxy for
+// a request.
+func (c HTTPClientConfig) proxyFunc() func(*http.Request) (*url.URL, error) {
+	if c.Proxy == "" {
+		return http.ProxyFromEnvironment
+	}
+
+	proxy := (&httpproxy.Config{
+		HTTPProxy:  c.Proxy,
+		HTTPSProxy: c.Proxy,
+		NoProxy:    c.NoProxy,
+	}).ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}
+}
+
+// NoProxyFromEnvironment returns the hosts listed in NO_PROXY, or in no_proxy
+// when NO_PROXY is unset, the same way the standard proxy environment is read.
+func NoProxyFromEnvironment() string {
+	return httpproxy.FromEnvironment().NoProxy
+}
+
+// newTransport returns a transport with the default settings plus the proxy
+// and TLS configuration. clientCert is optional.
+func (c HTTPClientConfig) newTransport(clientCert *tls.Certificate) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = c.proxyFunc()
+	if c.RootCAs != nil || clientCert != nil {
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    c.RootCAs,
+			MinVersion: tls.VersionTLS12,
+		}
+		if clientCert != nil {
+			transport.TLSClientConfig.Certificates = []tls.Certificate{*clientCert}
+		}
+	}
+	return transport
+}
+
+// LoadCABundle builds a certificate pool from the system pool plus the PEM
+// bundles in paths, which is a comma-separated list of files. It returns nil
+// when paths is empty.
+func LoadCABundle(paths string) (*x509.CertPool, error) {
+	if strings.TrimSpace(paths) == "" {
+		return nil, nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	for _, path := range strings.Split(paths, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		pemBytes, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA bundle: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(pemBytes) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", path)
+		}
+	}
+
+	return pool, nil
+}
+
+// newSourceHTTPClient returns an HTTP client configured for a source. When
+// the source properties set clientCertPath and clientKeyPath, the client
+// presents that certificate to the upstream. Relative paths are resolved
+// against reldir.
+func newSourceHTTPClient(sourceType string, properties map[string]any, reldir string) (*http.Client, error) {
+	clientCert, err := loadClientCertificate(properties, reldir)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := getHTTPClientConfig()
+	return &http.Client{
+		Timeout:   cfg.Timeout(sourceType),
+		Transport: cfg.newTransport(clientCert),
+	}, nil
+}
+
+// loadClientCertificate loads the client certificate configured in the source
+// properties. It returns nil when none is configured.
+func loadClientCertificate(properties map[string]any, reldir string) (*tls.Certificate, error) {
+	certPath, _ := properties[clientCertPathKey].(string)
+	keyPath, _ := properties[clientKeyPathKey].(string)
+	if certPath == "" && keyPath == "" {
+		return nil, nil
+	}
+	if certPath == "" || keyPath == "" {
+		return nil, fmt.Errorf("%s and %s must be set together", clientCertPathKey, clientKeyPathKey)
+	}
+
+	if !filepath.IsAbs(certPath) {
+		certPath = filepath.Join(reldir, certPath)
+	}
+	if !filepath.IsAbs(keyPath) {
+		keyPath = filepath.Join(reldir, keyPath)
+	}
+
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load client certificate: %w", err)
+	}
+	return &cert, nil
+}
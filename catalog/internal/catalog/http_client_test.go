@@ -0,0 +1,286 @@
+package catalog
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setHTTPClientConfigForTest swaps the source HTTP client configuration for
+// the duration of a test.
+func setHTTPClientConfigForTest(t *testing.T, cfg HTTPClientConfig) {
+	t.Helper()
+	previous := getHTTPClientConfig()
+	require.NoError(t, SetHTTPClientConfig(cfg))
+	t.Cleanup(func() { _ = SetHTTPClientConfig(previous) })
+}
+
+func newTestSourceHTTPClient(t *testing.T, properties map[string]any) *http.Client {
+	t.Helper()
+	client, err := newSourceHTTPClient("hf", properties, "")
+	require.NoError(t, err)
+	return client
+}
+
+func TestHTTPClientConfigTimeout(t *testing.T) {
+	cfg := HTTPClientConfig{
+		DefaultTimeout: 10 * time.Second,
+		Timeouts: map[string]time.Duration{
+			"hf":   2 * time.Minute,
+			"yaml": 0,
+		},
+	}
+
+	assert.Equal(t, 2*time.Minute, cfg.Timeout("hf"))
+	assert.Equal(t, 10*time.Second, cfg.Timeout("yaml"), "zero override falls back to the default")
+	assert.Equal(t, 10*time.Second, cfg.Timeout("other"))
+	assert.Equal(t, defaultHTTPTimeout, HTTPClientConfig{}.Timeout("hf"))
+}
+
+func TestHFProviderUsesConfiguredTimeout(t *testing.T) {
+	setHTTPClientConfigForTest(t, HTTPClientConfig{
+		DefaultTimeout: 5 * time.Second,
+		Timeouts:       map[string]time.Duration{"hf": 90 * time.Second},
+	})
+
+	p, err := NewHFPreviewProvider(&PreviewConfig{
+		Type:           "hf",
+		IncludedModels: []string{"org/model"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, p.client.Timeout)
+
+	setHTTPClientConfigForTest(t, HTTPClientConfig{DefaultTimeout: 5 * time.Second})
+
+	p, err = NewHFPreviewProvider(&PreviewConfig{
+		Type:           "huggingface",
+		IncludedModels: []string{"org/model"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, p.client.Timeout)
+}
+
+func TestSourceHTTPClientUsesConfiguredProxy(t *testing.T) {
+	setHTTPClientConfigForTest(t, HTTPClientConfig{
+		Proxy:   "http://proxy.example.com:3128",
+		NoProxy: "internal.example.com,.svc.cluster.local",
+	})
+
+	transport, ok := newTestSourceHTTPClient(t, nil).Transport.(*http.Transport)
+	require.True(t, ok)
+	require.NotNil(t, transport.Proxy)
+
+	proxyFor := func(rawURL string) *url.URL {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+		require.NoError(t, err)
+		proxyURL, err := transport.Proxy(req)
+		require.NoError(t, err)
+		return proxyURL
+	}
+
+	proxyURL := proxyFor("https://huggingface.co/api/models")
+	require.NotNil(t, proxyURL)
+	assert.Equal(t, "proxy.example.com:3128", proxyURL.Host)
+
+	proxyURL = proxyFor("http://huggingface.co/api/models")
+	require.NotNil(t, proxyURL)
+	assert.Equal(t, "proxy.example.com:3128", proxyURL.Host)
+
+	assert.Nil(t, proxyFor("https://internal.example.com/catalog.yaml"))
+	assert.Nil(t, proxyFor("http://catalog.ns.svc.cluster.local/catalog.yaml"))
+}
+
+func TestSourceHTTPClientWithoutProxyUsesEnvironment(t *testing.T) {
+	setHTTPClientConfigForTest(t, HTTPClientConfig{})
+
+	transport, ok := newTestSourceHTTPClient(t, nil).Transport.(*http.Transport)
+	require.True(t, ok)
+	require.NotNil(t, transport.Proxy)
+
+	// http.ProxyFromEnvironment caches the environment on first use, so only
+	// assert that the environment-based function is wired in.
+	assert.Equal(t, reflect.ValueOf(http.ProxyFromEnvironment).Pointer(), reflect.ValueOf(transport.Proxy).Pointer())
+}
+
+func TestNoProxyFromEnvironment(t *testing.T) {
+	t.Setenv("NO_PROXY", "")
+	t.Setenv("no_proxy", "")
+	assert.Empty(t, NoProxyFromEnvironment())
+
+	t.Setenv("no_proxy", "internal.example.com")
+	assert.Equal(t, "internal.example.com", NoProxyFromEnvironment())
+
+	t.Setenv("NO_PROXY", ".svc.cluster.local")
+	assert.Equal(t, ".svc.cluster.local", NoProxyFromEnvironment())
+}
+
+func TestLoadCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	bundlePath := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(bundlePath, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}), 0o600))
+
+	t.Run("empty paths", func(t *testing.T) {
+		pool, err := LoadCABundle("")
+		require.NoError(t, err)
+		assert.Nil(t, pool)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadCABundle(filepath.Join(dir, "missing.pem"))
+		assert.Error(t, err)
+	})
+
+	t.Run("file without certificates", func(t *testing.T) {
+		emptyPath := filepath.Join(dir, "empty.pem")
+		require.NoError(t, os.WriteFile(emptyPath, []byte("not a certificate"), 0o600))
+		_, err := LoadCABundle(emptyPath)
+		assert.ErrorContains(t, err, "no certificates found")
+	})
+
+	t.Run("configured pool verifies the upstream", func(t *testing.T) {
+		pool, err := LoadCABundle(" " + bundlePath + ", ")
+		require.NoError(t, err)
+		require.NotNil(t, pool)
+
+		setHTTPClientConfigForTest(t, HTTPClientConfig{RootCAs: pool})
+
+		client := newTestSourceHTTPClient(t, nil)
+		transport, ok := client.Transport.(*http.Transport)
+		require.True(t, ok)
+		require.NotNil(t, transport.TLSClientConfig)
+		assert.Same(t, pool, transport.TLSClientConfig.RootCAs)
+
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("default pool rejects the upstream", func(t *testing.T) {
+		setHTTPClientConfigForTest(t, HTTPClientConfig{})
+
+		_, err := newTestSourceHTTPClient(t, nil).Get(server.URL)
+		assert.Error(t, err)
+	})
+}
+
+// writeClientCertificate generates a self-signed client certificate and key
+// in dir and returns the parsed certificate.
+func writeClientCertificate(t *testing.T, dir string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "catalog-source-client"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tls.crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tls.key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return cert
+}
+
+func TestSourceHTTPClientPresentsClientCertificate(t *testing.T) {
+	dir := t.TempDir()
+	clientCert := writeClientCertificate(t, dir)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(r.TLS.PeerCertificates[0].Subject.CommonName))
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+	setHTTPClientConfigForTest(t, HTTPClientConfig{RootCAs: rootCAs})
+
+	t.Run("certificate relative to the source directory", func(t *testing.T) {
+		client, err := newSourceHTTPClient("hf", map[string]any{
+			clientCertPathKey: "tls.crt",
+			clientKeyPathKey:  "tls.key",
+		}, dir)
+		require.NoError(t, err)
+
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "catalog-source-client", string(body))
+	})
+
+	t.Run("no certificate is rejected by the upstream", func(t *testing.T) {
+		_, err := newTestSourceHTTPClient(t, nil).Get(server.URL)
+		assert.Error(t, err)
+	})
+
+	t.Run("certificate without key", func(t *testing.T) {
+		_, err := newSourceHTTPClient("hf", map[string]any{
+			clientCertPathKey: filepath.Join(dir, "tls.crt"),
+		}, "")
+		assert.ErrorContains(t, err, "must be set together")
+	})
+
+	t.Run("missing certificate file", func(t *testing.T) {
+		_, err := newSourceHTTPClient("hf", map[string]any{
+			clientCertPathKey: "missing.crt",
+			clientKeyPathKey:  "missing.key",
+		}, dir)
+		assert.ErrorContains(t, err, "unable to load client certificate")
+	})
+}
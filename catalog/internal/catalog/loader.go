@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"strconv"
 	"sync"
+	"time"
 
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/golang/glog"
@@ -49,6 +52,10 @@ type ModelProviderRecord struct {
 	Artifacts []dbmodels.CatalogArtifact
 	// Error can be set here to emit successfully loaded models before updating source status err.
 	Error error
+	// NotModified can be set on a record with a nil Model to indicate that the
+	// source still lists the same models as in its previous batch, which are
+	// then left untouched.
+	NotModified bool
 }
 
 // ModelProviderFunc emits models and related data in the channel it returns. It is
@@ -84,6 +91,15 @@ type sourceConfig struct {
 	Catalogs     []Source                          `json:"catalogs"`
 	Labels       []map[string]any                  `json:"labels,omitempty"`
 	NamedQueries map[string]map[string]FieldFilter `json:"namedQueries,omitempty" yaml:"namedQueries,omitempty"`
+
+	// Defaults holds default properties per source type. They are merged into
+	// the properties of every source of that type in the same file, with the
+	// source's own properties taking precedence.
+	Defaults map[string]map[string]any `json:"defaults,omitempty" yaml:"defaults,omitempty"`
+
+	// Variables are substituted for ${NAME} references in source properties.
+	// Names not defined here are resolved from the environment.
+	Variables map[string]string `json:"variables,omitempty" yaml:"variables,omitempty"`
 }
 
 // Source is a single entry from the catalog sources YAML file.
@@ -100,6 +116,10 @@ type Source struct {
 	// This is set automatically during loading and used for resolving relative paths.
 	// It is not read from YAML; it's set programmatically.
 	Origin string `json:"-" yaml:"-"`
+
+	// propertiesErr is set when Properties could not be prepared, e.g. because
+	// of unresolved variables. The source is then not loaded.
+	propertiesErr error
 }
 
 type Loader struct {
@@ -115,6 +135,12 @@ type Loader struct {
 	closer        func() // cancels the current model loading goroutines
 	handlers      []LoaderEventHandler
 	loadedSources map[string]bool // tracks which source IDs have been loaded
+
+	lastSeenMu sync.Mutex
+	lastSeen   map[string]map[string]time.Time // source ID -> model name -> last listed, for sources with an entity TTL
+
+	syncsMu sync.Mutex
+	syncs   map[string]sourceSync // source ID -> last successful sync
 }
 
 func NewLoader(services service.Services, paths []string) *Loader {
@@ -136,6 +162,8 @@ func NewLoader(services service.Services, paths []string) *Loader {
 		paths:         paths,
 		services:      services,
 		loadedSources: map[string]bool{},
+		lastSeen:      map[string]map[string]time.Time{},
+		syncs:         map[string]sourceSync{},
 	}
 }
 
@@ -249,6 +277,9 @@ func (l *Loader) read(path string) (*sourceConfig, error) {
 		return nil, err
 	}
 
+	applySourceDefaults(config)
+	expandSourceVariables(config)
+
 	// Validate named queries if present
 	if config.NamedQueries != nil {
 		if err := ValidateNamedQueries(config.NamedQueries); err != nil {
@@ -264,22 +295,91 @@ func (l *Loader) read(path string) (*sourceConfig, error) {
 	return config, nil
 }
 
+// validateSource checks a merged source before its models are loaded. A source
+// that fails is reported with an error status and not loaded; other sources
+// are not affected. Checks that need the source type are skipped when it is
+// not set, since it may come from a source in another file.
+func validateSource(source *Source) error {
+	if source.propertiesErr != nil {
+		return source.propertiesErr
+	}
+	if source.Type == "" {
+		return nil
+	}
+	if interval, ok := sourceSyncInterval(source); ok {
+		if _, err := parseSyncJitter(source, interval); err != nil {
+			return err
+		}
+		if _, err := parseMaintenanceWindows(source); err != nil {
+			return err
+		}
+	}
+	if _, err := parseEntityTTL(source); err != nil {
+		return err
+	}
+	return nil
+}
+
+// applySourceDefaults merges the per-type default properties of config into
+// each of its sources. Top-level keys set by a source override the default;
+// nested values are not merged.
+func applySourceDefaults(config *sourceConfig) {
+	for i := range config.Catalogs {
+		source := &config.Catalogs[i]
+		defaults := config.Defaults[source.Type]
+		if len(defaults) == 0 {
+			continue
+		}
+
+		properties := make(map[string]any, len(defaults)+len(source.Properties))
+		for key, value := range defaults {
+			properties[key] = deepCopyValue(value)
+		}
+		maps.Copy(properties, source.Properties)
+		source.Properties = properties
+	}
+}
+
+// deepCopyValue copies the maps and slices of a decoded YAML value so
+// sources sharing a default do not share its underlying storage.
+func deepCopyValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		c := make(map[string]any, len(v))
+		for key, item := range v {
+			c[key] = deepCopyValue(item)
+		}
+		return c
+	case []any:
+		c := make([]any, len(v))
+		for i, item := range v {
+			c[i] = deepCopyValue(item)
+		}
+		return c
+	default:
+		return value
+	}
+}
+
 func (l *Loader) updateSources(path string, config *sourceConfig) error {
 	sources := make(map[string]Source, len(config.Catalogs))
+	var errs []error
 
 	for _, source := range config.Catalogs {
 		glog.Infof("reading config type %s...", source.Type)
 		id := source.GetId()
 		if len(id) == 0 {
-			return fmt.Errorf("invalid source: missing id")
+			errs = append(errs, fmt.Errorf("invalid source: missing id"))
+			continue
 		}
 		if _, exists := sources[id]; exists {
-			return fmt.Errorf("invalid source: duplicate id %s", id)
+			errs = append(errs, fmt.Errorf("invalid source: duplicate id %s", id))
+			continue
 		}
 
 		// Validate includedModels/excludedModels patterns early (only if set)
 		if err := ValidateSourceFilters(source.IncludedModels, source.ExcludedModels); err != nil {
-			return fmt.Errorf("invalid source %s: %w", id, err)
+			errs = append(errs, fmt.Errorf("invalid source %s: %w", id, err))
 		}
 
 		// Set the origin path so relative paths in properties can be resolved
@@ -289,6 +389,10 @@ func (l *Loader) updateSources(path string, config *sourceConfig) error {
 		glog.Infof("loaded source %s of type %s", id, source.Type)
 	}
 
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
 	// Use MergeWithNamedQueries if named queries exist, otherwise use regular Merge
 	if config.NamedQueries != nil {
 		return l.Sources.MergeWithNamedQueries(path, sources, config.NamedQueries)
@@ -304,11 +408,15 @@ func (l *Loader) updateLabels(path string, config *sourceConfig) error {
 	}
 
 	// Validate that each label has a required "name" field
+	var errs []error
 	for i, label := range config.Labels {
 		if name, ok := label["name"]; !ok || name == "" {
-			return fmt.Errorf("invalid label at index %d: missing required 'name' field", i)
+			errs = append(errs, fmt.Errorf("invalid label at index %d: missing required 'name' field", i))
 		}
 	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
 
 	return l.Labels.Merge(path, config.Labels)
 }
@@ -419,6 +527,13 @@ func (l *Loader) readProviderRecords(ctx context.Context) <-chan ModelProviderRe
 			continue
 		}
 
+		if err := validateSource(&source); err != nil {
+			glog.Errorf("source %s is invalid, skipping: %v", source.Id, err)
+			l.saveSourceStatus(source.Id, SourceStatusError, err.Error())
+			continue
+		}
+		ttl, _ := parseEntityTTL(&source) // already checked by validateSource
+
 		// Mark this source as loaded
 		l.loadedSources[source.Id] = true
 
@@ -451,6 +566,31 @@ func (l *Loader) readProviderRecords(ctx context.Context) <-chan ModelProviderRe
 			statusSaved := false
 
 			for r := range records {
+				if r.Model == nil && r.NotModified {
+					glog.Infof("%s: not modified since the last sync", sourceID)
+					if ttl > 0 {
+						l.markSourceSeen(sourceID, time.Now())
+					}
+					if ctx.Err() == nil {
+						l.recordSourceSync(sourceID, true)
+						l.saveSourceStatus(sourceID, SourceStatusAvailable, "")
+						statusSaved = true
+					}
+					continue
+				}
+
+				if r.Model == nil && r.Error != nil && !errors.Is(r.Error, ErrPartiallyAvailable) {
+					// The source failed to list its models, so the
+					// models already loaded from it are kept.
+					glog.Errorf("%s: unable to load models: %v", sourceID, r.Error)
+					modelNames = modelNames[:0]
+					if ctx.Err() == nil {
+						l.saveSourceStatus(sourceID, SourceStatusError, r.Error.Error())
+						statusSaved = true
+					}
+					continue
+				}
+
 				if r.Model == nil {
 					glog.Infof("%s: loaded %d models", sourceID, len(modelNames))
 
@@ -458,16 +598,30 @@ func (l *Loader) readProviderRecords(ctx context.Context) <-chan ModelProviderRe
 					modelNameSet := mapset.NewSet(modelNames...)
 					modelNames = modelNames[:0]
 
-					go func() {
-						count, err := l.removeOrphanedModelsFromSource(sourceID, modelNameSet)
-						if err != nil {
-							glog.Errorf("error removing orphaned models: %v", err)
-						}
-						glog.Infof("%s: cleaned up %d models", sourceID, count)
-					}()
+					if ttl > 0 {
+						// Models missing from this batch are kept until
+						// they have not been seen for the entity TTL.
+						l.markModelsSeen(sourceID, modelNameSet, time.Now())
+						go func() {
+							count, err := l.removeExpiredModelsFromSource(sourceID, ttl, time.Now())
+							if err != nil {
+								glog.Errorf("error removing expired models: %v", err)
+							}
+							glog.Infof("%s: cleaned up %d expired models", sourceID, count)
+						}()
+					} else {
+						go func() {
+							count, err := l.removeOrphanedModelsFromSource(sourceID, modelNameSet)
+							if err != nil {
+								glog.Errorf("error removing orphaned models: %v", err)
+							}
+							glog.Infof("%s: cleaned up %d models", sourceID, count)
+						}()
+					}
 
 					// Only save status if context is still valid (no reload in progress)
 					if ctx.Err() == nil {
+						l.recordSourceSync(sourceID, false)
 						// Check if there was a partial error (some models failed to load)
 						if errors.Is(r.Error, ErrPartiallyAvailable) {
 							glog.Warningf("%s: partial error after loading models: %v", sourceID, r.Error)
@@ -493,6 +647,7 @@ func (l *Loader) readProviderRecords(ctx context.Context) <-chan ModelProviderRe
 			// If the channel closed without a nil Model marker and status wasn't already saved,
 			// save available status if context is still valid and we processed some models
 			if !statusSaved && ctx.Err() == nil && len(modelNames) > 0 {
+				l.recordSourceSync(sourceID, false)
 				l.saveSourceStatus(sourceID, SourceStatusAvailable, "")
 			}
 		}(ctx, source.Id)
@@ -560,6 +715,14 @@ func (l *Loader) removeModelsFromMissingSources() error {
 			return fmt.Errorf("unable to remove models from source %q: %w", oldSource, err)
 		}
 
+		l.lastSeenMu.Lock()
+		delete(l.lastSeen, oldSource)
+		l.lastSeenMu.Unlock()
+
+		l.syncsMu.Lock()
+		delete(l.syncs, oldSource)
+		l.syncsMu.Unlock()
+
 		// If the source is completely gone from config (not just disabled), remove its status too
 		if !allSourceIDs.Contains(oldSource) {
 			glog.Infof("Removing status for source %s (no longer in config)", oldSource)
@@ -662,6 +825,14 @@ func (l *Loader) saveSourceStatus(sourceID, status string, errorMsg string) {
 		props = append(props, mrmodels.NewStringProperty("error", errorMsg, false))
 	}
 
+	// Keep the last successful sync when an error is saved.
+	if sync, ok := l.lastSourceSync(sourceID); ok {
+		props = append(props,
+			mrmodels.NewStringProperty("lastSyncTimeSinceEpoch", strconv.FormatInt(sync.time.UnixMilli(), 10), false),
+			mrmodels.NewBoolProperty("notModified", sync.notModified, false),
+		)
+	}
+
 	source.Properties = &props
 
 	_, err := l.services.CatalogSourceRepository.Save(source)
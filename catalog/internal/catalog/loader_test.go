@@ -1,13 +1,22 @@
 package catalog
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	mapset "github.com/deckarep/golang-set/v2"
+	dbmodels "github.com/kubeflow/model-registry/catalog/internal/db/models"
 	"github.com/kubeflow/model-registry/catalog/internal/db/service"
 	apimodels "github.com/kubeflow/model-registry/catalog/pkg/openapi"
 	"github.com/kubeflow/model-registry/internal/apiutils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/yaml"
 )
 
@@ -225,3 +234,348 @@ namedQueries:
 	assert.Equal(t, "=", validationQuery["workload_type"].Operator)
 	assert.Equal(t, "Chat", validationQuery["workload_type"].Value)
 }
+
+func TestReadSourceConfigVariables(t *testing.T) {
+	t.Setenv("CATALOG_VAR_TEST_SYNC_INTERVAL", "30m")
+
+	path := filepath.Join(t.TempDir(), "sources.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+variables:
+  BASE_URL: https://models.example.com
+catalogs:
+  - name: First
+    id: first
+    type: url
+    properties:
+      url: ${BASE_URL}/first.yaml
+      syncInterval: ${CATALOG_VAR_TEST_SYNC_INTERVAL}
+  - name: Second
+    id: second
+    type: hf
+    properties:
+      url: ${BASE_URL}
+      allowedOrganization: example
+`), 0644))
+
+	config, err := (&Loader{}).read(path)
+	require.NoError(t, err)
+	require.Len(t, config.Catalogs, 2)
+
+	first := config.Catalogs[0]
+	assert.NoError(t, first.propertiesErr)
+	assert.Equal(t, map[string]any{"url": "https://models.example.com/first.yaml", "syncInterval": "30m"}, first.Properties)
+
+	second := config.Catalogs[1]
+	assert.NoError(t, second.propertiesErr)
+	assert.Equal(t, map[string]any{"url": "https://models.example.com", "allowedOrganization": "example"}, second.Properties)
+}
+
+func TestReadSourceConfigUnresolvedVariables(t *testing.T) {
+	// Only environment variables with the CATALOG_VAR_ prefix can be referenced.
+	t.Setenv("TEST_CATALOG_PASSWORD", "secret")
+
+	path := filepath.Join(t.TempDir(), "sources.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+catalogs:
+  - name: First
+    id: first
+    type: url
+    properties:
+      url: https://models.example.com/${CATALOG_VAR_TEST_UNDEFINED_B}/${CATALOG_VAR_TEST_UNDEFINED_A}.yaml
+  - name: Second
+    id: second
+    type: hf
+    properties:
+      allowedOrganization: ${TEST_CATALOG_PASSWORD}
+  - name: Third
+    id: third
+    type: hf
+    properties:
+      allowedOrganization: example
+`), 0644))
+
+	// Unresolved variables are an error of the source, not of the file.
+	config, err := (&Loader{}).read(path)
+	require.NoError(t, err)
+	require.Len(t, config.Catalogs, 3)
+
+	first := config.Catalogs[0]
+	require.Error(t, first.propertiesErr)
+	assert.Equal(t, "unresolved variables: CATALOG_VAR_TEST_UNDEFINED_A, CATALOG_VAR_TEST_UNDEFINED_B", first.propertiesErr.Error())
+
+	second := config.Catalogs[1]
+	require.Error(t, second.propertiesErr)
+	assert.Equal(t, "unresolved variables: TEST_CATALOG_PASSWORD", second.propertiesErr.Error())
+	assert.Equal(t, "${TEST_CATALOG_PASSWORD}", second.Properties["allowedOrganization"])
+
+	assert.NoError(t, config.Catalogs[2].propertiesErr)
+}
+
+func TestLoaderReportsUnresolvedVariablesAsSourceStatus(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sources.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+catalogs:
+  - name: Broken
+    id: broken
+    type: url
+    properties:
+      url: ${CATALOG_VAR_TEST_UNDEFINED}
+`), 0644))
+
+	sourceRepo := &MockCatalogSourceRepository{}
+	services := service.NewServices(
+		&MockCatalogModelRepository{},
+		&MockCatalogArtifactRepository{},
+		&MockCatalogModelArtifactRepository{},
+		&MockCatalogMetricsArtifactRepository{},
+		sourceRepo,
+		&MockPropertyOptionsRepository{},
+	)
+
+	loader := NewLoader(services, []string{path})
+	require.NoError(t, loader.parseAndMerge(path))
+
+	for range loader.readProviderRecords(t.Context()) {
+	}
+
+	statuses, err := sourceRepo.GetAllStatuses()
+	require.NoError(t, err)
+	assert.Equal(t, SourceStatusError, statuses["broken"].Status)
+	assert.Equal(t, "unresolved variables: CATALOG_VAR_TEST_UNDEFINED", statuses["broken"].Error)
+}
+
+func TestLoaderReportsProviderErrorsAsSourceStatus(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "sources.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+catalogs:
+  - name: Unavailable
+    id: unavailable
+    type: url
+    properties:
+      url: `+server.URL+`
+`), 0644))
+
+	sourceRepo := &MockCatalogSourceRepository{}
+	services := service.NewServices(
+		&MockCatalogModelRepository{},
+		&MockCatalogArtifactRepository{},
+		&MockCatalogModelArtifactRepository{},
+		&MockCatalogMetricsArtifactRepository{},
+		sourceRepo,
+		&MockPropertyOptionsRepository{},
+	)
+
+	loader := NewLoader(services, []string{path})
+	require.NoError(t, loader.parseAndMerge(path))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	records := loader.readProviderRecords(ctx)
+	t.Cleanup(func() {
+		cancel()
+		for range records {
+		}
+	})
+
+	// The source keeps syncing after the error, so its records never end.
+	assert.Eventually(t, func() bool {
+		statuses, err := sourceRepo.GetAllStatuses()
+		require.NoError(t, err)
+		return statuses["unavailable"].Status == SourceStatusError
+	}, 2*time.Second, 10*time.Millisecond)
+
+	statuses, err := sourceRepo.GetAllStatuses()
+	require.NoError(t, err)
+	assert.Contains(t, statuses["unavailable"].Error, "unexpected status 404")
+}
+
+func TestLoaderRecoversFromProviderErrorWithUnchangedCatalog(t *testing.T) {
+	catalog := &urlCatalogServer{}
+	catalog.set("Granite/alpha")
+
+	var mu sync.Mutex
+	failing := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		fail := failing
+		mu.Unlock()
+		if fail {
+			http.Error(w, "unavailable", http.StatusInternalServerError)
+			return
+		}
+		catalog.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "sources.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+catalogs:
+  - name: Flaky
+    id: flaky
+    type: url
+    properties:
+      url: `+server.URL+`
+      syncInterval: 20ms
+`), 0644))
+
+	sourceRepo := &MockCatalogSourceRepository{}
+	services := service.NewServices(
+		&MockCatalogModelRepository{},
+		&MockCatalogArtifactRepository{},
+		&MockCatalogModelArtifactRepository{},
+		&MockCatalogMetricsArtifactRepository{},
+		sourceRepo,
+		&MockPropertyOptionsRepository{},
+	)
+
+	loader := NewLoader(services, []string{path})
+	require.NoError(t, loader.parseAndMerge(path))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	records := loader.readProviderRecords(ctx)
+	go func() {
+		for range records {
+		}
+	}()
+	t.Cleanup(cancel)
+
+	sourceStatus := func() string {
+		statuses, err := sourceRepo.GetAllStatuses()
+		require.NoError(t, err)
+		return statuses["flaky"].Status
+	}
+
+	assert.Eventually(t, func() bool { return sourceStatus() == SourceStatusAvailable }, 2*time.Second, 5*time.Millisecond)
+
+	mu.Lock()
+	failing = true
+	mu.Unlock()
+	assert.Eventually(t, func() bool { return sourceStatus() == SourceStatusError }, 2*time.Second, 5*time.Millisecond)
+
+	// The catalog did not change while the server was failing, so a
+	// conditional request would only get 304 Not Modified.
+	mu.Lock()
+	failing = false
+	mu.Unlock()
+	assert.Eventually(t, func() bool { return sourceStatus() == SourceStatusAvailable }, 2*time.Second, 5*time.Millisecond)
+}
+
+func TestLoaderRecordsUnchangedSyncs(t *testing.T) {
+	catalog := &urlCatalogServer{}
+	catalog.set("Granite/alpha")
+	server := httptest.NewServer(catalog)
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "sources.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+catalogs:
+  - name: Unchanged
+    id: unchanged
+    type: url
+    properties:
+      url: `+server.URL+`
+      syncInterval: 20ms
+`), 0644))
+
+	sourceRepo := &MockCatalogSourceRepository{}
+	services := service.NewServices(
+		&MockCatalogModelRepository{},
+		&MockCatalogArtifactRepository{},
+		&MockCatalogModelArtifactRepository{},
+		&MockCatalogMetricsArtifactRepository{},
+		sourceRepo,
+		&MockPropertyOptionsRepository{},
+	)
+
+	loader := NewLoader(services, []string{path})
+	require.NoError(t, loader.parseAndMerge(path))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	records := loader.readProviderRecords(ctx)
+	go func() {
+		for range records {
+		}
+	}()
+	t.Cleanup(cancel)
+
+	sourceStatus := func() dbmodels.SourceStatus {
+		statuses, err := sourceRepo.GetAllStatuses()
+		require.NoError(t, err)
+		return statuses["unchanged"]
+	}
+
+	// The first sync loads the models.
+	var first dbmodels.SourceStatus
+	require.Eventually(t, func() bool {
+		first = sourceStatus()
+		return first.Status == SourceStatusAvailable
+	}, 2*time.Second, 5*time.Millisecond)
+	assert.False(t, first.NotModified)
+	assert.Positive(t, first.LastSyncTimeSinceEpoch)
+
+	// Later syncs find nothing new, but are still recorded.
+	assert.Eventually(t, func() bool {
+		status := sourceStatus()
+		return status.Status == SourceStatusAvailable &&
+			status.NotModified &&
+			status.LastSyncTimeSinceEpoch > first.LastSyncTimeSinceEpoch
+	}, 2*time.Second, 5*time.Millisecond)
+}
+
+func TestReadSourceConfigDefaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sources.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+variables:
+  BASE_URL: https://models.example.com
+defaults:
+  url:
+    url: ${BASE_URL}/default.yaml
+    syncInterval: 30m
+catalogs:
+  - name: Inherits
+    id: inherits
+    type: url
+  - name: Overrides
+    id: overrides
+    type: url
+    properties:
+      url: ${BASE_URL}/overrides.yaml
+  - name: Other type
+    id: other
+    type: hf
+    properties:
+      allowedOrganization: example
+`), 0644))
+
+	config, err := (&Loader{}).read(path)
+	require.NoError(t, err)
+	require.Len(t, config.Catalogs, 3)
+
+	assert.Equal(t, map[string]any{
+		"url":          "https://models.example.com/default.yaml",
+		"syncInterval": "30m",
+	}, config.Catalogs[0].Properties)
+	assert.Equal(t, map[string]any{
+		"url":          "https://models.example.com/overrides.yaml",
+		"syncInterval": "30m",
+	}, config.Catalogs[1].Properties)
+	assert.Equal(t, map[string]any{"allowedOrganization": "example"}, config.Catalogs[2].Properties)
+}
+
+func TestApplySourceDefaultsCopiesNestedValues(t *testing.T) {
+	config := &sourceConfig{
+		Defaults: map[string]map[string]any{
+			"url": {"nested": map[string]any{"key": "shared"}},
+		},
+		Catalogs: []Source{{Type: "url"}, {Type: "url"}},
+	}
+
+	applySourceDefaults(config)
+
+	// Sources must not share the default's nested values.
+	config.Catalogs[1].Properties["nested"].(map[string]any)["key"] = "changed"
+	assert.Equal(t, "shared", config.Catalogs[0].Properties["nested"].(map[string]any)["key"])
+	assert.Equal(t, "shared", config.Defaults["url"]["nested"].(map[string]any)["key"])
+}
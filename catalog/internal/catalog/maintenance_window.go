@@ -0,0 +1,204 @@
+package catalog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maintenanceWindowsKey is the source property listing the windows during
+// which a source does not sync. A sync that falls inside a window is
+// postponed until the window ends.
+const maintenanceWindowsKey = "maintenanceWindows"
+
+// maxMaintenanceWindowChain bounds how many adjacent or overlapping windows
+// are followed when looking for the end of a maintenance period.
+const maxMaintenanceWindowChain = 64
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// maintenanceWindow is a daily time range, in UTC, on some days of the week.
+// A window whose end is before its start ends on the following day.
+type maintenanceWindow struct {
+	days  [7]bool
+	start time.Duration
+	end   time.Duration
+}
+
+// parseMaintenanceWindows parses the maintenanceWindows property of a source.
+// Each window is written like the day-of-week field of a cron schedule
+// followed by a UTC time range, e.g. "Sat,Sun 01:00-05:00", "Mon-Fri
+// 22:00-02:00" or "* 03:00-03:30".
+func parseMaintenanceWindows(source *Source) ([]maintenanceWindow, error) {
+	value, ok := source.Properties[maintenanceWindowsKey]
+	if !ok {
+		return nil, nil
+	}
+
+	var specs []string
+	switch v := value.(type) {
+	case []string:
+		specs = v
+	case []any:
+		for _, item := range v {
+			spec, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid %s property: must be a list of strings", maintenanceWindowsKey)
+			}
+			specs = append(specs, spec)
+		}
+	default:
+		return nil, fmt.Errorf("invalid %s property: must be a list of strings", maintenanceWindowsKey)
+	}
+
+	windows := make([]maintenanceWindow, 0, len(specs))
+	for _, spec := range specs {
+		window, err := parseMaintenanceWindow(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s property: %q: %w", maintenanceWindowsKey, spec, err)
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+func parseMaintenanceWindow(spec string) (maintenanceWindow, error) {
+	window := maintenanceWindow{}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 2 {
+		return window, fmt.Errorf("expected days and a time range, e.g. \"Sat,Sun 01:00-05:00\"")
+	}
+
+	if err := parseWeekdays(fields[0], &window.days); err != nil {
+		return window, err
+	}
+
+	start, end, ok := strings.Cut(fields[1], "-")
+	if !ok {
+		return window, fmt.Errorf("time range %q must be HH:MM-HH:MM", fields[1])
+	}
+	var err error
+	if window.start, err = parseTimeOfDay(start); err != nil {
+		return window, err
+	}
+	if window.end, err = parseTimeOfDay(end); err != nil {
+		return window, err
+	}
+	if window.start == window.end {
+		return window, fmt.Errorf("time range %q is empty", fields[1])
+	}
+	return window, nil
+}
+
+func parseWeekdays(field string, days *[7]bool) error {
+	if field == "*" {
+		for i := range days {
+			days[i] = true
+		}
+		return nil
+	}
+
+	for _, part := range strings.Split(field, ",") {
+		first, last, isRange := strings.Cut(part, "-")
+		from, ok := weekdayNames[strings.ToLower(first)]
+		if !ok {
+			return fmt.Errorf("unknown day %q", first)
+		}
+		to := from
+		if isRange {
+			if to, ok = weekdayNames[strings.ToLower(last)]; !ok {
+				return fmt.Errorf("unknown day %q", last)
+			}
+		}
+		// Ranges may wrap around the end of the week, e.g. Fri-Mon.
+		for day := from; ; day = (day + 1) % 7 {
+			days[day] = true
+			if day == to {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func parseTimeOfDay(raw string) (time.Duration, error) {
+	hours, minutes, ok := strings.Cut(raw, ":")
+	if !ok {
+		return 0, fmt.Errorf("time %q must be HH:MM", raw)
+	}
+	h, err := strconv.Atoi(hours)
+	if err != nil || h < 0 || h > 24 {
+		return 0, fmt.Errorf("time %q has an invalid hour", raw)
+	}
+	m, err := strconv.Atoi(minutes)
+	if err != nil || m < 0 || m > 59 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("time %q has an invalid minute", raw)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// activeUntil returns the end of the occurrence of w that contains t, if any.
+func (w maintenanceWindow) activeUntil(t time.Time) (time.Time, bool) {
+	t = t.UTC()
+	today := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	// An occurrence may have started today or, if it wraps past midnight,
+	// yesterday.
+	for _, day := range []time.Time{today, today.AddDate(0, 0, -1)} {
+		if !w.days[day.Weekday()] {
+			continue
+		}
+		start := day.Add(w.start)
+		end := day.Add(w.end)
+		if w.end < w.start {
+			end = end.AddDate(0, 0, 1)
+		}
+		if !t.Before(start) && t.Before(end) {
+			return end, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// maintenanceWindowEnd returns when the maintenance period that contains t
+// ends, following windows that start as soon as another one ends. It returns
+// false if t is outside of every window.
+func maintenanceWindowEnd(windows []maintenanceWindow, t time.Time) (time.Time, bool) {
+	found := false
+	for range maxMaintenanceWindowChain {
+		extended := false
+		for _, w := range windows {
+			if end, ok := w.activeUntil(t); ok {
+				t = end
+				found = true
+				extended = true
+			}
+		}
+		if !extended {
+			break
+		}
+	}
+	return t, found
+}
+
+// nextSyncTimerDelay returns how long to wait from now before the next periodic
+// sync. The sync is due after the interval plus jitter; if that falls inside a
+// maintenance window, it runs once the window ends, again spread out by the
+// jitter.
+func nextSyncTimerDelay(now time.Time, interval, jitter time.Duration, windows []maintenanceWindow) time.Duration {
+	delay := nextSyncDelay(interval, jitter)
+	if end, ok := maintenanceWindowEnd(windows, now.Add(delay)); ok {
+		delay = end.Sub(now) + nextSyncDelay(0, jitter)
+	}
+	return delay
+}
@@ -0,0 +1,123 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 2026-10-17 is a Saturday.
+var maintenanceTestDay = time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+func mustParseMaintenanceWindows(t *testing.T, specs ...string) []maintenanceWindow {
+	t.Helper()
+
+	windows, err := parseMaintenanceWindows(&Source{Properties: map[string]any{maintenanceWindowsKey: specs}})
+	require.NoError(t, err)
+	return windows
+}
+
+func TestParseMaintenanceWindows(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value any
+		err   string
+	}{
+		{name: "weekend", value: []any{"Sat,Sun 01:00-05:00"}},
+		{name: "day range wrapping past midnight", value: []any{"Mon-Fri 22:00-02:00"}},
+		{name: "every day until midnight", value: []string{"* 23:00-24:00"}},
+		{name: "day range wrapping the week", value: []any{"fri-mon 03:00-03:30"}},
+		{name: "not a list", value: "Sat 01:00-05:00", err: "must be a list of strings"},
+		{name: "not a string", value: []any{5}, err: "must be a list of strings"},
+		{name: "missing time range", value: []any{"Sat"}, err: "expected days and a time range"},
+		{name: "unknown day", value: []any{"Caturday 01:00-02:00"}, err: `unknown day "Caturday"`},
+		{name: "missing end", value: []any{"Sat 01:00"}, err: "must be HH:MM-HH:MM"},
+		{name: "invalid hour", value: []any{"Sat 25:00-26:00"}, err: "invalid hour"},
+		{name: "invalid minute", value: []any{"Sat 01:60-02:00"}, err: "invalid minute"},
+		{name: "empty range", value: []any{"Sat 01:00-01:00"}, err: "is empty"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseMaintenanceWindows(&Source{Properties: map[string]any{maintenanceWindowsKey: tc.value}})
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMaintenanceWindowEnd(t *testing.T) {
+	testCases := []struct {
+		name     string
+		windows  []string
+		at       time.Duration
+		expected time.Duration
+		inside   bool
+	}{
+		{
+			name:     "inside a window",
+			windows:  []string{"Sat 01:00-05:00"},
+			at:       2 * time.Hour,
+			expected: 5 * time.Hour,
+			inside:   true,
+		},
+		{
+			name:    "at the end of a window",
+			windows: []string{"Sat 01:00-05:00"},
+			at:      5 * time.Hour,
+		},
+		{
+			name:    "on another day",
+			windows: []string{"Sun 01:00-05:00"},
+			at:      2 * time.Hour,
+		},
+		{
+			name:     "window that started the day before",
+			windows:  []string{"Fri 22:00-02:00"},
+			at:       time.Hour,
+			expected: 2 * time.Hour,
+			inside:   true,
+		},
+		{
+			name:     "adjacent windows",
+			windows:  []string{"Sat 01:00-02:00", "Sat 02:00-03:00"},
+			at:       90 * time.Minute,
+			expected: 3 * time.Hour,
+			inside:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			end, inside := maintenanceWindowEnd(mustParseMaintenanceWindows(t, tc.windows...), maintenanceTestDay.Add(tc.at))
+			assert.Equal(t, tc.inside, inside)
+			if tc.inside {
+				assert.Equal(t, maintenanceTestDay.Add(tc.expected), end)
+			}
+		})
+	}
+}
+
+func TestNextSyncTimerDelayPostponesSyncsInMaintenanceWindows(t *testing.T) {
+	windows := mustParseMaintenanceWindows(t, "Sat 01:00-05:00")
+	now := maintenanceTestDay.Add(30 * time.Minute)
+
+	// Due at 01:30, inside the window, so the sync runs when it ends.
+	assert.Equal(t, 4*time.Hour+30*time.Minute, nextSyncTimerDelay(now, time.Hour, 0, windows))
+
+	// Due at 00:50, before the window starts.
+	assert.Equal(t, 20*time.Minute, nextSyncTimerDelay(now, 20*time.Minute, 0, windows))
+
+	// Postponed syncs are still spread out by the jitter.
+	for range 100 {
+		delay := nextSyncTimerDelay(now, time.Hour, 10*time.Minute, windows)
+		require.GreaterOrEqual(t, delay, 4*time.Hour+30*time.Minute)
+		require.LessOrEqual(t, delay, 4*time.Hour+40*time.Minute)
+	}
+}
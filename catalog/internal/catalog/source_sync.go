@@ -0,0 +1,55 @@
+package catalog
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// sourceSync is the last successful sync of a source.
+type sourceSync struct {
+	time time.Time
+	// notModified is true when the source reported that nothing changed
+	// since its previous sync, so no models were loaded.
+	notModified bool
+}
+
+// recordSourceSync records that a source synced successfully just now.
+func (l *Loader) recordSourceSync(sourceID string, notModified bool) {
+	l.syncsMu.Lock()
+	defer l.syncsMu.Unlock()
+
+	l.syncs[sourceID] = sourceSync{time: time.Now(), notModified: notModified}
+}
+
+// lastSourceSync returns the last successful sync of a source. Syncs from
+// before this process started are read from the stored source status.
+func (l *Loader) lastSourceSync(sourceID string) (sourceSync, bool) {
+	l.syncsMu.Lock()
+	sync, ok := l.syncs[sourceID]
+	l.syncsMu.Unlock()
+	if ok {
+		return sync, true
+	}
+
+	statuses, err := l.services.CatalogSourceRepository.GetAllStatuses()
+	if err != nil {
+		glog.Warningf("unable to read the last sync of source %s: %v", sourceID, err)
+		return sourceSync{}, false
+	}
+	status, ok := statuses[sourceID]
+	if !ok || status.LastSyncTimeSinceEpoch <= 0 {
+		return sourceSync{}, false
+	}
+
+	sync = sourceSync{time: time.UnixMilli(status.LastSyncTimeSinceEpoch), notModified: status.NotModified}
+
+	l.syncsMu.Lock()
+	defer l.syncsMu.Unlock()
+	if current, ok := l.syncs[sourceID]; ok {
+		// A sync was recorded while reading the stored one.
+		return current, true
+	}
+	l.syncs[sourceID] = sync
+	return sync, true
+}
@@ -153,6 +153,7 @@ func mergeSources(base, override Source) Source {
 	// Properties: override if non-nil (complete replacement, not deep merge)
 	if override.Properties != nil {
 		result.Properties = override.Properties
+		result.propertiesErr = override.propertiesErr
 	}
 
 	// Origin: use override's origin if Properties are overridden (since relative
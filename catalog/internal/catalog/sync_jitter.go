@@ -0,0 +1,49 @@
+package catalog
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	// syncJitterKey is the source property that bounds the random delay added
+	// to every periodic sync of a source. Sources started together with the
+	// same syncInterval would otherwise all sync at the same moment.
+	syncJitterKey = "syncJitter"
+
+	// defaultSyncJitterDivisor sets the default syncJitter to a tenth of the
+	// sync interval.
+	defaultSyncJitterDivisor = 10
+)
+
+// parseSyncJitter parses the syncJitter property of a source that syncs every
+// interval. It returns a tenth of interval if the property is not set, and 0
+// disables the jitter.
+func parseSyncJitter(source *Source, interval time.Duration) (time.Duration, error) {
+	value, ok := source.Properties[syncJitterKey]
+	if !ok {
+		return interval / defaultSyncJitterDivisor, nil
+	}
+	raw, ok := value.(string)
+	if !ok {
+		return 0, fmt.Errorf("invalid %s property: must be a duration string", syncJitterKey)
+	}
+	jitter, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s property: %w", syncJitterKey, err)
+	}
+	if jitter < 0 {
+		return 0, fmt.Errorf("invalid %s property: must not be negative", syncJitterKey)
+	}
+	return jitter, nil
+}
+
+// nextSyncDelay returns how long to wait before the next periodic sync: the
+// interval plus a random duration of at most jitter.
+func nextSyncDelay(interval, jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return interval
+	}
+	return interval + rand.N(jitter+1)
+}
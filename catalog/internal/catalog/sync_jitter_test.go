@@ -0,0 +1,85 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSyncJitter(t *testing.T) {
+	testCases := []struct {
+		name       string
+		properties map[string]any
+		expected   time.Duration
+		err        string
+	}{
+		{
+			name:       "defaults to a tenth of the interval",
+			properties: map[string]any{},
+			expected:   6 * time.Minute,
+		},
+		{
+			name:       "configured",
+			properties: map[string]any{syncJitterKey: "15m"},
+			expected:   15 * time.Minute,
+		},
+		{
+			name:       "disabled",
+			properties: map[string]any{syncJitterKey: "0s"},
+			expected:   0,
+		},
+		{
+			name:       "not a duration",
+			properties: map[string]any{syncJitterKey: "a bit"},
+			err:        "invalid syncJitter property",
+		},
+		{
+			name:       "not a string",
+			properties: map[string]any{syncJitterKey: 5},
+			err:        "must be a duration string",
+		},
+		{
+			name:       "negative",
+			properties: map[string]any{syncJitterKey: "-1m"},
+			err:        "must not be negative",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			jitter, err := parseSyncJitter(&Source{Type: "url", Properties: tc.properties}, time.Hour)
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, jitter)
+		})
+	}
+}
+
+func TestNextSyncDelay(t *testing.T) {
+	interval := time.Hour
+	jitter := 10 * time.Minute
+
+	lowest, highest := interval+jitter, interval
+	for range 1000 {
+		delay := nextSyncDelay(interval, jitter)
+		require.GreaterOrEqual(t, delay, interval)
+		require.LessOrEqual(t, delay, interval+jitter)
+		lowest = min(lowest, delay)
+		highest = max(highest, delay)
+	}
+
+	// The delays are spread across the jitter window rather than bunched
+	// together.
+	assert.Less(t, lowest, interval+jitter/4)
+	assert.Greater(t, highest, interval+3*jitter/4)
+}
+
+func TestNextSyncDelayWithoutJitter(t *testing.T) {
+	assert.Equal(t, time.Hour, nextSyncDelay(time.Hour, 0))
+}
@@ -0,0 +1,276 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+const (
+	urlCatalogKey = "url"
+
+	// maxCatalogBytesKey is the property that limits the size of a URL
+	// catalog response.
+	maxCatalogBytesKey = "maxCatalogBytes"
+
+	// defaultURLSyncInterval is how often a URL catalog is fetched again when
+	// the syncInterval property is not set.
+	defaultURLSyncInterval = time.Hour
+
+	// defaultMaxCatalogBytes is the largest URL catalog response accepted
+	// when the maxCatalogBytes property is not set.
+	defaultMaxCatalogBytes int64 = 32 << 20
+)
+
+// urlCatalogContentTypes are the response media types accepted for a URL
+// catalog. A response without a Content-Type is also accepted.
+var urlCatalogContentTypes = map[string]bool{
+	"application/yaml":         true,
+	"application/x-yaml":       true,
+	"text/yaml":                true,
+	"text/x-yaml":              true,
+	"text/plain":               true,
+	"application/json":         true,
+	"application/octet-stream": true,
+}
+
+func init() {
+	if err := RegisterModelProvider("url", newURLModelProvider); err != nil {
+		panic(err)
+	}
+}
+
+// urlModelProvider serves a YAML catalog fetched over HTTP(S). The catalog is
+// fetched again every syncInterval; unchanged content is detected with
+// ETag/Last-Modified validators and not re-emitted. Syncs that fall inside a
+// maintenance window are postponed until it ends. A failed fetch is
+// reported as an end-of-batch record with an Error and retried on the next
+// sync.
+type urlModelProvider struct {
+	url          string
+	client       *http.Client
+	syncInterval time.Duration
+	syncJitter   time.Duration
+	maxBytes     int64
+	yaml         *yamlModelProvider
+
+	maintenanceWindows []maintenanceWindow
+
+	etag         string
+	lastModified string
+}
+
+func (p *urlModelProvider) Models(ctx context.Context) (<-chan ModelProviderRecord, error) {
+	ch := make(chan ModelProviderRecord)
+	go func() {
+		defer close(ch)
+
+		// Send the initial list right away. If the first fetch fails, keep
+		// trying on every sync instead of giving up on the source.
+		p.sync(ctx, ch)
+
+		timer := time.NewTimer(nextSyncTimerDelay(time.Now(), p.syncInterval, p.syncJitter, p.maintenanceWindows))
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+				timer.Reset(nextSyncTimerDelay(time.Now(), p.syncInterval, p.syncJitter, p.maintenanceWindows))
+				p.sync(ctx, ch)
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// sync fetches the catalog once and sends its models, a not modified marker,
+// or an end-of-batch record with the fetch error.
+func (p *urlModelProvider) sync(ctx context.Context, ch chan<- ModelProviderRecord) {
+	catalog, err := p.fetch(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		glog.Errorf("unable to fetch URL catalog %s: %v", p.url, err)
+		// Forget the validators, so the next successful fetch is emitted in
+		// full and the loader sets the source status again.
+		p.etag = ""
+		p.lastModified = ""
+		select {
+		case ch <- ModelProviderRecord{Error: err}:
+		case <-ctx.Done():
+		}
+		return
+	}
+	if catalog == nil {
+		// Not modified since the last fetch.
+		select {
+		case ch <- ModelProviderRecord{NotModified: true}:
+		case <-ctx.Done():
+		}
+		return
+	}
+
+	glog.Infof("Loading URL catalog %s", p.url)
+	p.yaml.emit(ctx, catalog, ch)
+}
+
+// fetch downloads and parses the catalog. It returns a nil catalog without an
+// error when the server reports that the content has not changed.
+func (p *urlModelProvider) fetch(ctx context.Context) (*yamlCatalog, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/yaml, text/yaml;q=0.9, */*;q=0.1")
+	if p.etag != "" {
+		req.Header.Set("If-None-Match", p.etag)
+	}
+	if p.lastModified != "" {
+		req.Header.Set("If-Modified-Since", p.lastModified)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", p.url, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("failed to fetch %s: unexpected status %s", p.url, resp.Status)
+	}
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || !urlCatalogContentTypes[mediaType] {
+			return nil, fmt.Errorf("failed to fetch %s: unsupported content type %q", p.url, contentType)
+		}
+	}
+
+	// Read one byte past the limit to tell a catalog of exactly maxBytes
+	// from a larger one.
+	buf, err := io.ReadAll(io.LimitReader(resp.Body, p.maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", p.url, err)
+	}
+	if int64(len(buf)) > p.maxBytes {
+		return nil, fmt.Errorf("failed to read %s: catalog is larger than %s of %d bytes", p.url, maxCatalogBytesKey, p.maxBytes)
+	}
+
+	catalog, err := parseYamlCatalog(buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", p.url, err)
+	}
+
+	p.etag = resp.Header.Get("ETag")
+	p.lastModified = resp.Header.Get("Last-Modified")
+
+	return catalog, nil
+}
+
+// parseCatalogURL validates the url property of a URL catalog source.
+func parseCatalogURL(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s property: %w", urlCatalogKey, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("invalid %s property: scheme must be http or https, got %q", urlCatalogKey, u.Scheme)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid %s property: missing host", urlCatalogKey)
+	}
+	return u.String(), nil
+}
+
+// parseMaxCatalogBytes returns the maxCatalogBytes property of a URL catalog
+// source, or defaultMaxCatalogBytes if it is not set.
+func parseMaxCatalogBytes(source *Source) (int64, error) {
+	value, ok := source.Properties[maxCatalogBytesKey]
+	if !ok {
+		return defaultMaxCatalogBytes, nil
+	}
+
+	var maxBytes int64
+	switch v := value.(type) {
+	case int:
+		maxBytes = int64(v)
+	case int64:
+		maxBytes = v
+	case float64:
+		maxBytes = int64(v)
+	default:
+		return 0, fmt.Errorf("invalid %s property: must be a number", maxCatalogBytesKey)
+	}
+	if maxBytes <= 0 {
+		return 0, fmt.Errorf("invalid %s property: must be positive", maxCatalogBytesKey)
+	}
+	return maxBytes, nil
+}
+
+func newURLModelProvider(ctx context.Context, source *Source, reldir string) (<-chan ModelProviderRecord, error) {
+	raw, ok := source.Properties[urlCatalogKey].(string)
+	if !ok || raw == "" {
+		return nil, fmt.Errorf("missing %s string property", urlCatalogKey)
+	}
+
+	catalogURL, err := parseCatalogURL(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newSourceHTTPClient("url", source.Properties, reldir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL catalog source %s: %w", source.GetId(), err)
+	}
+
+	filter, err := NewModelFilterFromSource(source, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &urlModelProvider{
+		url:          catalogURL,
+		client:       client,
+		syncInterval: defaultURLSyncInterval,
+		yaml:         &yamlModelProvider{filter: filter},
+	}
+
+	if syncInterval, ok := source.Properties[syncIntervalKey].(string); ok && syncInterval != "" {
+		if parsed, err := time.ParseDuration(syncInterval); err == nil && parsed > 0 {
+			p.syncInterval = parsed
+		} else {
+			glog.Warningf("Invalid syncInterval duration string %q, using default %v", syncInterval, defaultURLSyncInterval)
+		}
+	}
+
+	p.syncJitter, err = parseSyncJitter(source, p.syncInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	p.maintenanceWindows, err = parseMaintenanceWindows(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p.maxBytes, err = parseMaxCatalogBytes(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Models(ctx)
+}
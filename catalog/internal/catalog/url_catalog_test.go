@@ -0,0 +1,297 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	model "github.com/kubeflow/model-registry/catalog/pkg/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// urlCatalogServer serves a mini YAML catalog whose content can be replaced
+// during a test. Conditional requests for the current version get 304.
+type urlCatalogServer struct {
+	mu      sync.Mutex
+	version int
+	models  []string
+}
+
+func (s *urlCatalogServer) set(models ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.version++
+	s.models = models
+}
+
+func (s *urlCatalogServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	etag := fmt.Sprintf(`"v%d"`, s.version)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString("source: Test\nmodels:\n")
+	for _, name := range s.models {
+		b.WriteString(fmt.Sprintf("  - name: %s\n", name))
+	}
+
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Header().Set("ETag", etag)
+	_, _ = w.Write([]byte(b.String()))
+}
+
+func newURLSource(properties map[string]any) *Source {
+	return &Source{
+		CatalogSource: model.CatalogSource{
+			Id:     "remote",
+			Name:   "Remote source",
+			Labels: []string{},
+		},
+		Type:       "url",
+		Properties: properties,
+	}
+}
+
+// collectBatch reads records until the end-of-batch marker and returns the
+// model names received. Not modified markers are skipped.
+func collectBatch(t *testing.T, records <-chan ModelProviderRecord, timeout time.Duration) []string {
+	t.Helper()
+
+	names := []string{}
+	deadline := time.After(timeout)
+	for {
+		select {
+		case record, ok := <-records:
+			if !ok {
+				t.Fatalf("channel closed before end of batch")
+			}
+			if record.NotModified {
+				continue
+			}
+			if record.Model == nil {
+				return names
+			}
+			names = append(names, modelNameFromRecord(t, record))
+		case <-deadline:
+			t.Fatalf("timed out waiting for batch")
+		}
+	}
+}
+
+func TestURLModelProvider(t *testing.T) {
+	catalog := &urlCatalogServer{}
+	catalog.set("Granite/alpha", "Granite/beta-release", "DeepSeek/v1")
+	server := httptest.NewServer(catalog)
+	t.Cleanup(server.Close)
+
+	source := newURLSource(map[string]any{urlCatalogKey: server.URL + "/catalog.yaml"})
+	source.IncludedModels = []string{"Granite/*"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	records, err := newURLModelProvider(ctx, source, "")
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"Granite/alpha", "Granite/beta-release"}, collectBatch(t, records, 2*time.Second))
+}
+
+func TestURLModelProviderRefresh(t *testing.T) {
+	catalog := &urlCatalogServer{}
+	catalog.set("Granite/alpha")
+	server := httptest.NewServer(catalog)
+	t.Cleanup(server.Close)
+
+	source := newURLSource(map[string]any{
+		urlCatalogKey:   server.URL,
+		syncIntervalKey: "20ms",
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	records, err := newURLModelProvider(ctx, source, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Granite/alpha"}, collectBatch(t, records, 2*time.Second))
+
+	// Unchanged content is reported as not modified instead of emitted again.
+	for range 2 {
+		select {
+		case record := <-records:
+			require.True(t, record.NotModified, "unexpected record for unchanged catalog: %+v", record)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for not modified record")
+		}
+	}
+
+	catalog.set("Granite/alpha", "Granite/beta")
+	assert.ElementsMatch(t, []string{"Granite/alpha", "Granite/beta"}, collectBatch(t, records, 2*time.Second))
+}
+
+func TestURLModelProviderErrors(t *testing.T) {
+	testCases := []struct {
+		name       string
+		properties map[string]any
+		expected   string
+	}{
+		{
+			name:       "missing url",
+			properties: map[string]any{},
+			expected:   "missing url string property",
+		},
+		{
+			name:       "unsupported scheme",
+			properties: map[string]any{urlCatalogKey: "file:///etc/catalog.yaml"},
+			expected:   "scheme must be http or https",
+		},
+		{
+			name:       "missing host",
+			properties: map[string]any{urlCatalogKey: "https:///catalog.yaml"},
+			expected:   "missing host",
+		},
+		{
+			name:       "invalid max catalog bytes",
+			properties: map[string]any{urlCatalogKey: "https://models.example.com", maxCatalogBytesKey: "1MB"},
+			expected:   "invalid maxCatalogBytes property",
+		},
+		{
+			name:       "non-positive max catalog bytes",
+			properties: map[string]any{urlCatalogKey: "https://models.example.com", maxCatalogBytesKey: 0},
+			expected:   "must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newURLModelProvider(t.Context(), newURLSource(tc.properties), "")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expected)
+		})
+	}
+}
+
+// nextRecord returns the next record from records, failing the test after
+// timeout.
+func nextRecord(t *testing.T, records <-chan ModelProviderRecord, timeout time.Duration) ModelProviderRecord {
+	t.Helper()
+
+	select {
+	case record, ok := <-records:
+		require.True(t, ok, "channel closed")
+		return record
+	case <-time.After(timeout):
+		t.Fatalf("timed out waiting for record")
+		return ModelProviderRecord{}
+	}
+}
+
+func TestURLModelProviderFetchErrors(t *testing.T) {
+	htmlServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte("<html></html>"))
+	}))
+	t.Cleanup(htmlServer.Close)
+
+	notFoundServer := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(notFoundServer.Close)
+
+	catalog := &urlCatalogServer{}
+	catalog.set("Granite/alpha", "Granite/beta")
+	catalogServer := httptest.NewServer(catalog)
+	t.Cleanup(catalogServer.Close)
+
+	testCases := []struct {
+		name       string
+		properties map[string]any
+		expected   string
+	}{
+		{
+			name:       "unsupported content type",
+			properties: map[string]any{urlCatalogKey: htmlServer.URL},
+			expected:   `unsupported content type "text/html"`,
+		},
+		{
+			name:       "error status",
+			properties: map[string]any{urlCatalogKey: notFoundServer.URL},
+			expected:   "unexpected status 404",
+		},
+		{
+			name:       "catalog too large",
+			properties: map[string]any{urlCatalogKey: catalogServer.URL, maxCatalogBytesKey: 16},
+			expected:   "catalog is larger than maxCatalogBytes of 16 bytes",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			records, err := newURLModelProvider(t.Context(), newURLSource(tc.properties), "")
+			require.NoError(t, err)
+
+			record := nextRecord(t, records, 2*time.Second)
+			assert.Nil(t, record.Model)
+			require.Error(t, record.Error)
+			assert.Contains(t, record.Error.Error(), tc.expected)
+		})
+	}
+}
+
+func TestURLModelProviderRecoversFromInitialFailure(t *testing.T) {
+	catalog := &urlCatalogServer{}
+	catalog.set("Granite/alpha")
+
+	var mu sync.Mutex
+	failing := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		fail := failing
+		mu.Unlock()
+		if fail {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		catalog.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	source := newURLSource(map[string]any{
+		urlCatalogKey:   server.URL,
+		syncIntervalKey: "20ms",
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	records, err := newURLModelProvider(ctx, source, "")
+	require.NoError(t, err)
+
+	record := nextRecord(t, records, 2*time.Second)
+	require.Error(t, record.Error)
+	assert.Contains(t, record.Error.Error(), "unexpected status 503")
+
+	mu.Lock()
+	failing = false
+	mu.Unlock()
+
+	for {
+		record := nextRecord(t, records, 2*time.Second)
+		if record.Error != nil {
+			// Syncs that ran before the server recovered.
+			continue
+		}
+		require.NotNil(t, record.Model)
+		assert.Equal(t, "Granite/alpha", modelNameFromRecord(t, record))
+		break
+	}
+	assert.Nil(t, nextRecord(t, records, 2*time.Second).Model)
+}
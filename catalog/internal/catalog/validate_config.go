@@ -0,0 +1,225 @@
+package catalog
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/kubeflow/model-registry/catalog/internal/db/service"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Validation layers reported by ValidateSourceConfigFile, in the order they
+// run.
+const (
+	ValidationLayerYAML     = "yaml_parse"
+	ValidationLayerStrict   = "strict_fields"
+	ValidationLayerSemantic = "semantic"
+)
+
+// sensitivePropertyKeys are substrings of property names whose values are
+// likely credentials.
+var sensitivePropertyKeys = []string{"password", "passwd", "secret", "token", "apikey", "api_key", "credential"}
+
+// ValidationLayerResult is the outcome of one validation layer.
+type ValidationLayerResult struct {
+	Name    string
+	Passed  bool
+	Skipped bool
+	Errors  []string
+}
+
+// ConfigValidationResult is the outcome of validating a source configuration
+// file without loading it.
+type ConfigValidationResult struct {
+	Path     string
+	Layers   []ValidationLayerResult
+	Warnings []string
+}
+
+// Valid reports whether every layer that ran passed.
+func (r *ConfigValidationResult) Valid() bool {
+	for _, layer := range r.Layers {
+		if !layer.Skipped && !layer.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateSourceConfigFile checks a source configuration file the same way
+// the loader does, without contacting any source or database. The returned
+// error is only set when the file cannot be read; validation problems are
+// reported in the result.
+func ValidateSourceConfigFile(path string) (*ConfigValidationResult, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ConfigValidationResult{Path: path}
+	config := &sourceConfig{}
+
+	if err := yaml.Unmarshal(bytes, config); err != nil {
+		result.Layers = append(result.Layers,
+			ValidationLayerResult{Name: ValidationLayerYAML, Errors: []string{err.Error()}},
+			ValidationLayerResult{Name: ValidationLayerStrict, Skipped: true},
+			ValidationLayerResult{Name: ValidationLayerSemantic, Skipped: true},
+		)
+		return result, nil
+	}
+	result.Layers = append(result.Layers, ValidationLayerResult{Name: ValidationLayerYAML, Passed: true})
+
+	strict := ValidationLayerResult{Name: ValidationLayerStrict, Passed: true}
+	if err := yaml.UnmarshalStrict(bytes, &sourceConfig{}); err != nil {
+		strict.Passed = false
+		strict.Errors = []string{err.Error()}
+	}
+	result.Layers = append(result.Layers, strict)
+
+	// Look for literal credentials before defaults and variables are applied,
+	// so values that already come from ${NAME} references are not reported.
+	for _, source := range config.Catalogs {
+		for _, key := range sensitiveLiteralProperties(source.Properties) {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("source %s: property %q looks like a credential stored in plain text; reference it with ${NAME} instead", source.GetId(), key))
+		}
+	}
+
+	// The loader parses strictly, so its checks can only run on a file
+	// without unknown fields.
+	if !strict.Passed {
+		result.Layers = append(result.Layers, ValidationLayerResult{Name: ValidationLayerSemantic, Skipped: true})
+		return result, nil
+	}
+
+	semanticErrors, warnings := validateSourceConfig(path)
+	result.Layers = append(result.Layers, ValidationLayerResult{
+		Name:   ValidationLayerSemantic,
+		Passed: len(semanticErrors) == 0,
+		Errors: semanticErrors,
+	})
+	result.Warnings = append(result.Warnings, warnings...)
+
+	return result, nil
+}
+
+// validateSourceConfig reads the file at path into a loader without services,
+// so it goes through the same parsing, merging and source checks as when the
+// server loads it. The offline provider checks of validateSourceProperties
+// are added on top.
+func validateSourceConfig(path string) (errs []string, warnings []string) {
+	errs = []string{}
+
+	loader := NewLoader(service.Services{}, []string{path})
+	if err := loader.parseAndMerge(path); err != nil {
+		return append(errs, splitJoinedError(err)...), nil
+	}
+
+	sources := loader.Sources.AllSources()
+	ids := slices.Sorted(maps.Keys(sources))
+	for _, id := range ids {
+		source := sources[id]
+
+		if err := validateSource(&source); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid source %s: %v", id, err))
+			continue
+		}
+		if source.Type == "" {
+			warnings = append(warnings, fmt.Sprintf("source %s has no type; it must override a source defined in another file", id))
+			continue
+		}
+		if _, ok := registeredModelProviders[source.Type]; !ok {
+			errs = append(errs, fmt.Sprintf("invalid source %s: catalog type %q not registered", id, source.Type))
+			continue
+		}
+		if err := validateSourceProperties(&source, filepath.Dir(source.Origin)); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid source %s: %v", id, err))
+		}
+	}
+
+	return errs, warnings
+}
+
+// splitJoinedError returns the messages of the errors combined with
+// errors.Join, or the message of err itself.
+func splitJoinedError(err error) []string {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		messages := []string{}
+		for _, e := range joined.Unwrap() {
+			messages = append(messages, splitJoinedError(e)...)
+		}
+		return messages
+	}
+	return []string{err.Error()}
+}
+
+// validateSourceProperties checks the properties of the built-in provider
+// types that can be verified without network access.
+func validateSourceProperties(source *Source, reldir string) error {
+	switch source.Type {
+	case "yaml":
+		path, ok := source.Properties[yamlCatalogPathKey].(string)
+		if !ok || path == "" {
+			return fmt.Errorf("missing %s string property", yamlCatalogPathKey)
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(reldir, path)
+		}
+		if _, err := os.Stat(path); err != nil {
+			return fmt.Errorf("invalid %s property: %w", yamlCatalogPathKey, err)
+		}
+	case "url":
+		raw, ok := source.Properties[urlCatalogKey].(string)
+		if !ok || raw == "" {
+			return fmt.Errorf("missing %s string property", urlCatalogKey)
+		}
+		if _, err := parseCatalogURL(raw); err != nil {
+			return err
+		}
+		if _, err := parseMaxCatalogBytes(source); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sensitiveLiteralProperties returns the sorted names of properties that look
+// like credentials and hold a literal value rather than only variable
+// references. Nested maps are reported with dotted names.
+func sensitiveLiteralProperties(properties map[string]any) []string {
+	keys := []string{}
+	for key, value := range properties {
+		switch v := value.(type) {
+		case string:
+			if isSensitivePropertyKey(key) && strings.TrimSpace(variablePattern.ReplaceAllString(v, "")) != "" {
+				keys = append(keys, key)
+			}
+		case map[string]any:
+			for _, nested := range sensitiveLiteralProperties(v) {
+				keys = append(keys, key+"."+nested)
+			}
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func isSensitivePropertyKey(key string) bool {
+	key = strings.ToLower(key)
+	// Properties naming where a credential is found, e.g. apiKeyEnvVar or
+	// clientKeyFile, do not hold the credential itself.
+	for _, suffix := range []string{"envvar", "file", "path"} {
+		if strings.HasSuffix(key, suffix) {
+			return false
+		}
+	}
+	for _, sensitive := range sensitivePropertyKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
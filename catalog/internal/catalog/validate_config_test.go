@@ -0,0 +1,281 @@
+package catalog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeValidationConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "models.yaml"), []byte("source: Test\nmodels: []\n"), 0o644))
+	path := filepath.Join(dir, "sources.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func layerResult(t *testing.T, result *ConfigValidationResult, name string) ValidationLayerResult {
+	t.Helper()
+
+	for _, layer := range result.Layers {
+		if layer.Name == name {
+			return layer
+		}
+	}
+	t.Fatalf("layer %s not found", name)
+	return ValidationLayerResult{}
+}
+
+func TestValidateSourceConfigFile(t *testing.T) {
+	testCases := []struct {
+		name         string
+		config       string
+		valid        bool
+		failedLayer  string
+		errorMessage string
+	}{
+		{
+			name: "valid",
+			config: `
+catalogs:
+  - id: local
+    name: Local
+    type: yaml
+    properties:
+      yamlCatalogPath: models.yaml
+  - id: remote
+    name: Remote
+    type: url
+    properties:
+      url: https://models.example.com/catalog.yaml
+`,
+			valid: true,
+		},
+		{
+			name:        "invalid yaml",
+			config:      "catalogs: [",
+			failedLayer: ValidationLayerYAML,
+		},
+		{
+			name: "unknown field",
+			config: `
+catalogs:
+  - id: local
+    name: Local
+    type: yaml
+    propertes: {}
+`,
+			failedLayer:  ValidationLayerStrict,
+			errorMessage: "propertes",
+		},
+		{
+			name: "duplicate id",
+			config: `
+catalogs:
+  - id: local
+    type: yaml
+    properties:
+      yamlCatalogPath: models.yaml
+  - id: local
+    type: yaml
+    properties:
+      yamlCatalogPath: models.yaml
+`,
+			failedLayer:  ValidationLayerSemantic,
+			errorMessage: "duplicate id local",
+		},
+		{
+			name: "unregistered type",
+			config: `
+catalogs:
+  - id: local
+    type: ftp
+`,
+			failedLayer:  ValidationLayerSemantic,
+			errorMessage: `catalog type "ftp" not registered`,
+		},
+		{
+			name: "missing catalog file",
+			config: `
+catalogs:
+  - id: local
+    type: yaml
+    properties:
+      yamlCatalogPath: missing.yaml
+`,
+			failedLayer:  ValidationLayerSemantic,
+			errorMessage: "invalid yamlCatalogPath property",
+		},
+		{
+			name: "invalid url",
+			config: `
+catalogs:
+  - id: remote
+    type: url
+    properties:
+      url: ftp://models.example.com/catalog.yaml
+`,
+			failedLayer:  ValidationLayerSemantic,
+			errorMessage: "scheme must be http or https",
+		},
+		{
+			name: "invalid max catalog bytes",
+			config: `
+catalogs:
+  - id: remote
+    type: url
+    properties:
+      url: https://models.example.com/catalog.yaml
+      maxCatalogBytes: 0
+`,
+			failedLayer:  ValidationLayerSemantic,
+			errorMessage: "invalid maxCatalogBytes property: must be positive",
+		},
+		{
+			name: "entity TTL shorter than sync interval",
+			config: `
+catalogs:
+  - id: hf
+    type: hf
+    properties:
+      entityTTL: 1h
+`,
+			failedLayer:  ValidationLayerSemantic,
+			errorMessage: "must be longer than the 26h24m0s between two syncs",
+		},
+		{
+			name: "invalid sync jitter",
+			config: `
+catalogs:
+  - id: remote
+    type: url
+    properties:
+      url: https://models.example.com/catalog.yaml
+      syncJitter: -5m
+`,
+			failedLayer:  ValidationLayerSemantic,
+			errorMessage: "invalid syncJitter property: must not be negative",
+		},
+		{
+			name: "invalid maintenance window",
+			config: `
+catalogs:
+  - id: remote
+    type: url
+    properties:
+      url: https://models.example.com/catalog.yaml
+      maintenanceWindows: ["Caturday 01:00-02:00"]
+`,
+			failedLayer:  ValidationLayerSemantic,
+			errorMessage: `unknown day "Caturday"`,
+		},
+		{
+			name: "unresolved variable",
+			config: `
+catalogs:
+  - id: remote
+    type: url
+    properties:
+      url: ${VALIDATE_TEST_UNSET_URL}
+`,
+			failedLayer:  ValidationLayerSemantic,
+			errorMessage: "unresolved variables: VALIDATE_TEST_UNSET_URL",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := ValidateSourceConfigFile(writeValidationConfig(t, tc.config))
+			require.NoError(t, err)
+			assert.Equal(t, tc.valid, result.Valid())
+
+			if tc.failedLayer != "" {
+				layer := layerResult(t, result, tc.failedLayer)
+				assert.False(t, layer.Passed)
+				require.NotEmpty(t, layer.Errors)
+				assert.Contains(t, layer.Errors[0], tc.errorMessage)
+			}
+		})
+	}
+}
+
+func TestValidateSourceConfigFileSkipsLayersAfterParseError(t *testing.T) {
+	result, err := ValidateSourceConfigFile(writeValidationConfig(t, "catalogs: ["))
+	require.NoError(t, err)
+
+	assert.True(t, layerResult(t, result, ValidationLayerStrict).Skipped)
+	assert.True(t, layerResult(t, result, ValidationLayerSemantic).Skipped)
+}
+
+func TestValidateSourceConfigFileSkipsSemanticAfterStrictError(t *testing.T) {
+	result, err := ValidateSourceConfigFile(writeValidationConfig(t, `
+catalogs:
+  - id: local
+    type: yaml
+    propertes: {}
+`))
+	require.NoError(t, err)
+
+	assert.False(t, layerResult(t, result, ValidationLayerStrict).Passed)
+	assert.True(t, layerResult(t, result, ValidationLayerSemantic).Skipped)
+}
+
+func TestValidateSourceConfigFileReportsEveryError(t *testing.T) {
+	result, err := ValidateSourceConfigFile(writeValidationConfig(t, `
+catalogs:
+  - name: No id
+    type: yaml
+  - id: local
+    type: yaml
+    includedModels: [""]
+    properties:
+      yamlCatalogPath: models.yaml
+  - id: local
+    type: yaml
+labels:
+  - displayName: No name
+`))
+	require.NoError(t, err)
+
+	semantic := layerResult(t, result, ValidationLayerSemantic)
+	assert.False(t, semantic.Passed)
+	assert.Len(t, semantic.Errors, 3)
+	assert.Contains(t, semantic.Errors[0], "invalid source: missing id")
+	assert.Contains(t, semantic.Errors[1], "invalid source local")
+	assert.Contains(t, semantic.Errors[2], "invalid source: duplicate id local")
+}
+
+func TestValidateSourceConfigFileSecurityWarnings(t *testing.T) {
+	path := writeValidationConfig(t, `
+variables:
+  HF_TOKEN: from-variables
+catalogs:
+  - id: hf
+    name: Hugging Face
+    type: hf
+    properties:
+      apiKeyEnvVar: HF_API_KEY
+      token: ${HF_TOKEN}
+      password: hunter2
+      auth:
+        clientSecret: s3cr3t
+`)
+
+	result, err := ValidateSourceConfigFile(path)
+	require.NoError(t, err)
+	assert.True(t, result.Valid())
+
+	require.Len(t, result.Warnings, 2)
+	assert.Contains(t, result.Warnings[0], `"auth.clientSecret"`)
+	assert.Contains(t, result.Warnings[1], `"password"`)
+}
+
+func TestValidateSourceConfigFileMissingFile(t *testing.T) {
+	_, err := ValidateSourceConfigFile(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
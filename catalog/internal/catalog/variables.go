@@ -0,0 +1,83 @@
+package catalog
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	mapset "github.com/deckarep/golang-set/v2"
+)
+
+// variablePattern matches ${NAME} references in source property values.
+var variablePattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// envVariablePrefix is the prefix of the environment variables that source
+// properties may reference. Other environment variables are never read, so a
+// source configuration cannot expose the rest of the server's environment,
+// such as its database credentials.
+const envVariablePrefix = "CATALOG_VAR_"
+
+// expandSourceVariables replaces ${NAME} references in the properties of every
+// source in config. Names are looked up in the config's variables map first
+// and then, for names starting with CATALOG_VAR_, in the environment. A source
+// with references that cannot be resolved keeps them unexpanded and gets an
+// error listing every unresolved name, reported as that source's status when
+// it is loaded.
+func expandSourceVariables(config *sourceConfig) {
+	lookup := func(name string) (string, bool) {
+		if value, ok := config.Variables[name]; ok {
+			return value, true
+		}
+		if strings.HasPrefix(name, envVariablePrefix) {
+			return os.LookupEnv(name)
+		}
+		return "", false
+	}
+
+	for i := range config.Catalogs {
+		source := &config.Catalogs[i]
+		if len(source.Properties) == 0 {
+			continue
+		}
+
+		unresolved := mapset.NewThreadUnsafeSet[string]()
+		source.Properties = expandVariables(source.Properties, lookup, unresolved).(map[string]any)
+
+		if unresolved.Cardinality() > 0 {
+			names := unresolved.ToSlice()
+			slices.Sort(names)
+			source.propertiesErr = fmt.Errorf("unresolved variables: %s", strings.Join(names, ", "))
+		}
+	}
+}
+
+// expandVariables walks a decoded YAML value and substitutes variable
+// references in every string it contains. Names that cannot be resolved are
+// added to unresolved and left in place.
+func expandVariables(value any, lookup func(string) (string, bool), unresolved mapset.Set[string]) any {
+	switch v := value.(type) {
+	case string:
+		return variablePattern.ReplaceAllStringFunc(v, func(ref string) string {
+			name := variablePattern.FindStringSubmatch(ref)[1]
+			if resolved, ok := lookup(name); ok {
+				return resolved
+			}
+			unresolved.Add(name)
+			return ref
+		})
+	case map[string]any:
+		for key, item := range v {
+			v[key] = expandVariables(item, lookup, unresolved)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = expandVariables(item, lookup, unresolved)
+		}
+		return v
+	default:
+		return value
+	}
+}
@@ -354,11 +354,20 @@ func (p *yamlModelProvider) read() (*yamlCatalog, error) {
 		return nil, fmt.Errorf("failed to read %s file: %v", yamlCatalogPathKey, err)
 	}
 
-	var catalog yamlCatalog
-	if err = yaml.UnmarshalStrict(buf, &catalog); err != nil {
+	catalog, err := parseYamlCatalog(buf)
+	if err != nil {
 		return nil, fmt.Errorf("failed to parse %s file: %v", yamlCatalogPathKey, err)
 	}
 
+	return catalog, nil
+}
+
+// parseYamlCatalog decodes the contents of a YAML catalog.
+func parseYamlCatalog(buf []byte) (*yamlCatalog, error) {
+	var catalog yamlCatalog
+	if err := yaml.UnmarshalStrict(buf, &catalog); err != nil {
+		return nil, err
+	}
 	return &catalog, nil
 }
 
@@ -26,6 +26,11 @@ type CatalogSourceImpl = models.BaseEntity[CatalogSourceAttributes]
 type SourceStatus struct {
 	Status string
 	Error  string
+	// LastSyncTimeSinceEpoch is when the source last loaded or confirmed its
+	// models, in milliseconds since epoch, or 0 if it never did.
+	LastSyncTimeSinceEpoch int64
+	// NotModified reports whether that sync found no upstream changes.
+	NotModified bool
 }
 
 // CatalogSourceRepository defines the interface for catalog source persistence.
@@ -3,6 +3,7 @@ package service
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/kubeflow/model-registry/catalog/internal/db/models"
@@ -202,6 +203,14 @@ func (r *CatalogSourceRepositoryImpl) GetAllStatuses() (map[string]models.Source
 					if prop.StringValue != nil {
 						status.Error = *prop.StringValue
 					}
+				case "lastSyncTimeSinceEpoch":
+					if prop.StringValue != nil {
+						status.LastSyncTimeSinceEpoch, _ = strconv.ParseInt(*prop.StringValue, 10, 64)
+					}
+				case "notModified":
+					if prop.BoolValue != nil {
+						status.NotModified = *prop.BoolValue
+					}
 				}
 			}
 		}
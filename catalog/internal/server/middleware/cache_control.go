@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const catalogPathPrefix = "/api/model_catalog/v1alpha1/"
+
+// CacheControlConfig holds the max-age advertised to browsers and proxies
+// for each class of read endpoint. A zero duration leaves the Cache-Control
+// header unset for that class. Responses to requests with an Authorization
+// header are marked private, so shared caches never store them.
+type CacheControlConfig struct {
+	// Discovery covers the sources and labels endpoints.
+	Discovery time.Duration
+
+	// FilterOptions covers the models/filter_options endpoint.
+	FilterOptions time.Duration
+
+	// Models covers model lists, model reads and their artifacts.
+	Models time.Duration
+}
+
+// DefaultCacheControlConfig returns the max-age values used when nothing else
+// is configured.
+func DefaultCacheControlConfig() CacheControlConfig {
+	return CacheControlConfig{
+		Discovery:     60 * time.Second,
+		FilterOptions: 60 * time.Second,
+		Models:        30 * time.Second,
+	}
+}
+
+// maxAge returns the configured max-age for the request path.
+func (c CacheControlConfig) maxAge(path string) time.Duration {
+	rest, ok := strings.CutPrefix(path, catalogPathPrefix)
+	if !ok {
+		return 0
+	}
+
+	switch {
+	case rest == "sources" || rest == "labels":
+		return c.Discovery
+	case rest == "models/filter_options":
+		return c.FilterOptions
+	case rest == "models":
+		return c.Models
+	case strings.HasPrefix(rest, "sources/") && strings.Contains(rest, "/models/"):
+		return c.Models
+	default:
+		return 0
+	}
+}
+
+// cacheControlWriter sets the Cache-Control header right before a successful
+// response is written, so errors are never cached.
+type cacheControlWriter struct {
+	http.ResponseWriter
+	value       string
+	wroteHeader bool
+}
+
+func (w *cacheControlWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if status == http.StatusOK && w.Header().Get("Cache-Control") == "" {
+			w.Header().Set("Cache-Control", w.value)
+		}
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *cacheControlWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// CacheControl adds a Cache-Control header to successful GET responses from
+// the catalog read endpoints according to cfg. Responses to authenticated
+// requests may only be cached by the client that made them.
+func CacheControl(next http.Handler, cfg CacheControlConfig) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		maxAge := cfg.maxAge(r.URL.Path)
+		if maxAge <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		scope := "public"
+		if r.Header.Get("Authorization") != "" {
+			scope = "private"
+		}
+
+		next.ServeHTTP(&cacheControlWriter{
+			ResponseWriter: w,
+			value:          fmt.Sprintf("%s, max-age=%d", scope, int(maxAge.Seconds())),
+		}, r)
+	})
+}
@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheControl(t *testing.T) {
+	cfg := CacheControlConfig{
+		Discovery:     2 * time.Minute,
+		FilterOptions: time.Minute,
+		Models:        30 * time.Second,
+	}
+
+	testCases := []struct {
+		name     string
+		method   string
+		path     string
+		status   int
+		expected string
+	}{
+		{
+			name:     "sources",
+			method:   http.MethodGet,
+			path:     "/api/model_catalog/v1alpha1/sources",
+			status:   http.StatusOK,
+			expected: "public, max-age=120",
+		},
+		{
+			name:     "labels",
+			method:   http.MethodGet,
+			path:     "/api/model_catalog/v1alpha1/labels",
+			status:   http.StatusOK,
+			expected: "public, max-age=120",
+		},
+		{
+			name:     "filter options",
+			method:   http.MethodGet,
+			path:     "/api/model_catalog/v1alpha1/models/filter_options",
+			status:   http.StatusOK,
+			expected: "public, max-age=60",
+		},
+		{
+			name:     "model list",
+			method:   http.MethodGet,
+			path:     "/api/model_catalog/v1alpha1/models",
+			status:   http.StatusOK,
+			expected: "public, max-age=30",
+		},
+		{
+			name:     "model read",
+			method:   http.MethodGet,
+			path:     "/api/model_catalog/v1alpha1/sources/hf/models/org/model",
+			status:   http.StatusOK,
+			expected: "public, max-age=30",
+		},
+		{
+			name:     "model artifacts",
+			method:   http.MethodGet,
+			path:     "/api/model_catalog/v1alpha1/sources/hf/models/org%2Fmodel/artifacts",
+			status:   http.StatusOK,
+			expected: "public, max-age=30",
+		},
+		{
+			name:   "error responses are not cached",
+			method: http.MethodGet,
+			path:   "/api/model_catalog/v1alpha1/sources/hf/models/missing",
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "preview is not cached",
+			method: http.MethodPost,
+			path:   "/api/model_catalog/v1alpha1/sources/preview",
+			status: http.StatusOK,
+		},
+		{
+			name:   "unknown paths are not cached",
+			method: http.MethodGet,
+			path:   "/healthz",
+			status: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := CacheControl(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+			}), cfg)
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(tc.method, tc.path, nil))
+
+			assert.Equal(t, tc.status, rr.Code)
+			assert.Equal(t, tc.expected, rr.Header().Get("Cache-Control"))
+		})
+	}
+}
+
+func TestCacheControlAuthenticatedRequestsArePrivate(t *testing.T) {
+	handler := CacheControl(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}), DefaultCacheControlConfig())
+
+	req := httptest.NewRequest(http.MethodGet, "/api/model_catalog/v1alpha1/models", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, "private, max-age=30", rr.Header().Get("Cache-Control"))
+}
+
+func TestCacheControlDisabled(t *testing.T) {
+	handler := CacheControl(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}), CacheControlConfig{})
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/model_catalog/v1alpha1/sources", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Empty(t, rr.Header().Get("Cache-Control"))
+}
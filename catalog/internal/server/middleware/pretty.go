@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+)
+
+// prettyParam is the query parameter that requests indented JSON output.
+const prettyParam = "pretty"
+
+// PrettyJSON indents JSON response bodies when the request has ?pretty=true,
+// for easier reading while debugging. Responses are left compact otherwise,
+// and non-JSON responses are never modified.
+func PrettyJSON(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if pretty, _ := strconv.ParseBool(r.URL.Query().Get(prettyParam)); !pretty {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := newResponseRecorder()
+		next.ServeHTTP(rec, r)
+		resp := rec.result()
+
+		body := resp.body
+		if isJSON(resp.header.Get("Content-Type")) {
+			var indented bytes.Buffer
+			if err := json.Indent(&indented, bytes.TrimSpace(body), "", "  "); err == nil {
+				indented.WriteByte('\n')
+				body = indented.Bytes()
+			}
+		}
+
+		for k, values := range resp.header {
+			w.Header()[k] = values
+		}
+		if w.Header().Get("Content-Length") != "" {
+			w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		}
+		w.WriteHeader(resp.status)
+		if r.Method != http.MethodHead {
+			_, _ = w.Write(body)
+		}
+	})
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "application/json"
+}
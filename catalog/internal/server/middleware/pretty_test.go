@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrettyJSON(t *testing.T) {
+	jsonHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"items":[{"id":"a"}],"size":1}` + "\n"))
+	})
+
+	testCases := []struct {
+		name     string
+		handler  http.Handler
+		target   string
+		expected string
+	}{
+		{
+			name:     "compact by default",
+			handler:  jsonHandler,
+			target:   "/api/model_catalog/v1alpha1/sources",
+			expected: `{"items":[{"id":"a"}],"size":1}` + "\n",
+		},
+		{
+			name:     "compact when pretty is false",
+			handler:  jsonHandler,
+			target:   "/api/model_catalog/v1alpha1/sources?pretty=false",
+			expected: `{"items":[{"id":"a"}],"size":1}` + "\n",
+		},
+		{
+			name:     "indented when pretty is true",
+			handler:  jsonHandler,
+			target:   "/api/model_catalog/v1alpha1/sources?pretty=true",
+			expected: "{\n  \"items\": [\n    {\n      \"id\": \"a\"\n    }\n  ],\n  \"size\": 1\n}\n",
+		},
+		{
+			name: "non-JSON responses are untouched",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				_, _ = w.Write([]byte(`{"a":1}`))
+			}),
+			target:   "/healthz?pretty=true",
+			expected: `{"a":1}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			PrettyJSON(tc.handler).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, tc.target, nil))
+
+			assert.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, tc.expected, rr.Body.String())
+		})
+	}
+}
+
+func TestPrettyJSONPreservesStatus(t *testing.T) {
+	handler := PrettyJSON(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"404","message":"not found"}`))
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/model_catalog/v1alpha1/sources/x?pretty=1", nil))
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	assert.Equal(t, "{\n  \"code\": \"404\",\n  \"message\": \"not found\"\n}\n", rr.Body.String())
+}
@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// coalescedHeaders are the request headers that can change a response and are
+// therefore part of the deduplication key.
+var coalescedHeaders = []string{"Accept", "Accept-Encoding", "Authorization"}
+
+// recordedResponse is a buffered copy of a response that can be replayed to
+// every request sharing a flight.
+type recordedResponse struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+// responseRecorder buffers a handler's response instead of writing it to the
+// client.
+type responseRecorder struct {
+	status int
+	header http.Header
+	body   bytes.Buffer
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{header: http.Header{}}
+}
+
+func (rec *responseRecorder) Header() http.Header {
+	return rec.header
+}
+
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	return rec.body.Write(b)
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+}
+
+func (rec *responseRecorder) result() *recordedResponse {
+	status := rec.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return &recordedResponse{
+		status: status,
+		header: rec.header,
+		body:   rec.body.Bytes(),
+	}
+}
+
+// Singleflight coalesces identical concurrent read requests so that they share
+// a single call to the wrapped handler. Requests are identical when they have
+// the same method, URL and response-affecting headers. Only GET and HEAD
+// requests are coalesced; everything else is passed through unchanged.
+//
+// The shared call runs with a context that is not canceled when the client
+// that started it goes away, so that the other waiting clients still get a
+// response.
+func Singleflight(next http.Handler) http.Handler {
+	var group singleflight.Group
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		v, _, _ := group.Do(requestKey(r), func() (any, error) {
+			rec := newResponseRecorder()
+			next.ServeHTTP(rec, r.WithContext(context.WithoutCancel(r.Context())))
+			return rec.result(), nil
+		})
+		resp := v.(*recordedResponse)
+
+		for k, values := range resp.header {
+			w.Header()[k] = append([]string(nil), values...)
+		}
+		w.WriteHeader(resp.status)
+		if r.Method != http.MethodHead {
+			_, _ = w.Write(resp.body)
+		}
+	})
+}
+
+func requestKey(r *http.Request) string {
+	var sb strings.Builder
+	sb.WriteString(r.Method)
+	sb.WriteByte(' ')
+	sb.WriteString(r.URL.RequestURI())
+	for _, h := range coalescedHeaders {
+		sb.WriteByte('\n')
+		sb.WriteString(h)
+		sb.WriteByte(':')
+		sb.WriteString(strings.Join(r.Header.Values(h), ","))
+	}
+	return sb.String()
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSingleflightCoalescesIdenticalGets(t *testing.T) {
+	const concurrency = 20
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	backend := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"items":[]}`))
+	})
+
+	server := httptest.NewServer(Singleflight(backend))
+	defer server.Close()
+
+	var wg sync.WaitGroup
+	bodies := make([]string, concurrency)
+	statuses := make([]int, concurrency)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := http.Get(server.URL + "/api/model_catalog/v1alpha1/sources")
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+			bodies[i] = string(body)
+			statuses[i] = resp.StatusCode
+			assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		}(i)
+	}
+
+	// Give every request time to join the in-flight call before releasing it.
+	require.Eventually(t, func() bool { return calls.Load() >= 1 }, time.Second, time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	for i := 0; i < concurrency; i++ {
+		assert.Equal(t, http.StatusOK, statuses[i])
+		assert.Equal(t, `{"items":[]}`, bodies[i])
+	}
+}
+
+func TestSingleflightKeepsDistinctRequestsApart(t *testing.T) {
+	var calls atomic.Int32
+	backend := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(r.URL.RawQuery))
+	})
+	handler := Singleflight(backend)
+
+	for _, target := range []string{"/models?source=a", "/models?source=b"} {
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, target, nil))
+		assert.Equal(t, target[len("/models?"):], rr.Body.String())
+	}
+
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestSingleflightPassesThroughMutations(t *testing.T) {
+	var calls atomic.Int32
+	backend := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusCreated)
+	})
+	handler := Singleflight(backend)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/sources/preview", nil))
+
+	assert.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, int32(1), calls.Load())
+}
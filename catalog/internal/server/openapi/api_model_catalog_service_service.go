@@ -11,6 +11,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/kubeflow/model-registry/catalog/internal/catalog"
 	"github.com/kubeflow/model-registry/catalog/internal/db/models"
@@ -44,14 +45,14 @@ func (m *ModelCatalogServiceAPIService) GetAllModelArtifacts(ctx context.Context
 	}
 
 	var err error
-	pageSizeInt := int32(10)
+	pageSizeInt := defaultPageSize
 
 	if pageSize != "" {
 		parsed, err := strconv.ParseInt(pageSize, 10, 32)
 		if err != nil {
 			return Response(http.StatusBadRequest, err), err
 		}
-		pageSizeInt = int32(parsed)
+		pageSizeInt = clampPageSize(int32(parsed))
 	}
 
 	// Handle multiple artifact types
@@ -96,14 +97,14 @@ func (m *ModelCatalogServiceAPIService) GetAllModelPerformanceArtifacts(ctx cont
 	}
 
 	var err error
-	pageSizeInt := int32(10)
+	pageSizeInt := defaultPageSize
 
 	if pageSize != "" {
 		parsed, err := strconv.ParseInt(pageSize, 10, 32)
 		if err != nil {
 			return Response(http.StatusBadRequest, err), err
 		}
-		pageSizeInt = int32(parsed)
+		pageSizeInt = clampPageSize(int32(parsed))
 	}
 
 	// Call the provider's GetPerformanceArtifacts method
@@ -207,14 +208,14 @@ func (m *ModelCatalogServiceAPIService) FindLabels(ctx context.Context, pageSize
 func (m *ModelCatalogServiceAPIService) FindModels(ctx context.Context, recommended bool, targetRPS int32, latencyProperty string, rpsProperty string, hardwareCountProperty string, hardwareTypeProperty string, sourceIDs []string, q string, sourceLabels []string, filterQuery string, pageSize string, orderBy model.OrderByField, sortOrder model.SortOrder, nextPageToken string) (ImplResponse, error) {
 	// Validate pagination parameters
 	var err error
-	pageSizeInt := int32(10)
+	pageSizeInt := defaultPageSize
 
 	if pageSize != "" {
 		parsed, err := strconv.ParseInt(pageSize, 10, 32)
 		if err != nil {
 			return ErrorResponse(http.StatusBadRequest, fmt.Errorf("invalid pagination parameters: %w", err)), err
 		}
-		pageSizeInt = int32(parsed)
+		pageSizeInt = clampPageSize(int32(parsed))
 	}
 
 	if len(sourceIDs) == 1 && sourceIDs[0] == "" {
@@ -377,6 +378,8 @@ func (m *ModelCatalogServiceAPIService) FindSources(ctx context.Context, name st
 	items := make([]model.CatalogSource, 0, len(sources))
 
 	name = strings.ToLower(name)
+	fullSources := m.sources.AllSources()
+	now := time.Now()
 
 	for _, v := range sources {
 		if !strings.Contains(strings.ToLower(v.Name), name) {
@@ -395,6 +398,18 @@ func (m *ModelCatalogServiceAPIService) FindSources(ctx context.Context, name st
 				} else {
 					v.Error = *model.NewNullableString(nil)
 				}
+				if status.LastSyncTimeSinceEpoch > 0 {
+					v.LastSyncTimeSinceEpoch = model.PtrString(strconv.FormatInt(status.LastSyncTimeSinceEpoch, 10))
+					v.NotModified = model.PtrBool(status.NotModified)
+
+					// Sources that only load on configuration changes are
+					// never stale, however long ago they loaded.
+					if full, ok := fullSources[v.Id]; ok && full.SyncsPeriodically() {
+						if stale, ok := isStaleSync(time.UnixMilli(status.LastSyncTimeSinceEpoch), now); ok {
+							v.Stale = model.PtrBool(stale)
+						}
+					}
+				}
 			}
 		}
 
@@ -420,13 +435,13 @@ func (m *ModelCatalogServiceAPIService) FindSources(ctx context.Context, name st
 
 func (m *ModelCatalogServiceAPIService) PreviewCatalogSource(ctx context.Context, configParam *os.File, pageSizeParam string, nextPageTokenParam string, filterStatusParam string, catalogDataParam *os.File) (ImplResponse, error) {
 	// Parse page size
-	pageSize := int32(10)
+	pageSize := defaultPageSize
 	if pageSizeParam != "" {
 		parsed, err := strconv.ParseInt(pageSizeParam, 10, 32)
 		if err != nil {
 			return ErrorResponse(http.StatusBadRequest, fmt.Errorf("invalid pageSize: %w", err)), err
 		}
-		pageSize = int32(parsed)
+		pageSize = clampPageSize(int32(parsed))
 	}
 
 	// Parse filterStatus (default: "all")
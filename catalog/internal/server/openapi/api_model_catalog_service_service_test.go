@@ -2056,3 +2056,123 @@ func TestGetAllModelPerformanceArtifactsWithConfigurableProperties(t *testing.T)
 		})
 	}
 }
+
+func TestFindSourcesClampsPageSize(t *testing.T) {
+	t.Cleanup(func() { SetMaxPageSize(0) })
+	SetMaxPageSize(2)
+
+	sources := catalog.NewSourceCollection()
+	sources.Merge("", map[string]catalog.Source{
+		"source1": {CatalogSource: model.CatalogSource{Id: "source1", Name: "Source 1"}},
+		"source2": {CatalogSource: model.CatalogSource{Id: "source2", Name: "Source 2"}},
+		"source3": {CatalogSource: model.CatalogSource{Id: "source3", Name: "Source 3"}},
+	})
+	service := NewModelCatalogServiceAPIService(&mockModelProvider{}, sources, catalog.NewLabelCollection(), nil)
+
+	resp, err := service.FindSources(context.Background(), "", "100000", model.ORDERBYFIELD_ID, model.SORTORDER_ASC, "")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	sourceList, ok := resp.Body.(model.CatalogSourceList)
+	require.True(t, ok, "Response body should be a CatalogSourceList")
+	assert.Equal(t, int32(2), sourceList.PageSize)
+	assert.Len(t, sourceList.Items, 2)
+	assert.NotEmpty(t, sourceList.NextPageToken)
+}
+
+// statusSourceRepository is a CatalogSourceRepository that only reports
+// source statuses.
+type statusSourceRepository struct {
+	dbmodels.CatalogSourceRepository
+	statuses map[string]dbmodels.SourceStatus
+}
+
+func (r *statusSourceRepository) GetAllStatuses() (map[string]dbmodels.SourceStatus, error) {
+	return r.statuses, nil
+}
+
+func TestFindSourcesReportsLastSync(t *testing.T) {
+	sources := catalog.NewSourceCollection()
+	sources.Merge("", map[string]catalog.Source{
+		"changed":   {CatalogSource: model.CatalogSource{Id: "changed", Name: "Changed"}},
+		"unchanged": {CatalogSource: model.CatalogSource{Id: "unchanged", Name: "Unchanged"}},
+		"new":       {CatalogSource: model.CatalogSource{Id: "new", Name: "New"}},
+	})
+	repository := &statusSourceRepository{statuses: map[string]dbmodels.SourceStatus{
+		"changed":   {Status: catalog.SourceStatusAvailable, LastSyncTimeSinceEpoch: 1000},
+		"unchanged": {Status: catalog.SourceStatusAvailable, LastSyncTimeSinceEpoch: 2000, NotModified: true},
+		"new":       {Status: catalog.SourceStatusError, Error: "unreachable"},
+	}}
+	service := NewModelCatalogServiceAPIService(&mockModelProvider{}, sources, catalog.NewLabelCollection(), repository)
+
+	resp, err := service.FindSources(context.Background(), "", "10", model.ORDERBYFIELD_ID, model.SORTORDER_ASC, "")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	sourceList, ok := resp.Body.(model.CatalogSourceList)
+	require.True(t, ok, "Response body should be a CatalogSourceList")
+	items := map[string]*model.CatalogSource{}
+	for _, item := range sourceList.Items {
+		items[item.Id] = &item
+	}
+
+	assert.Equal(t, "1000", items["changed"].GetLastSyncTimeSinceEpoch())
+	assert.False(t, items["changed"].GetNotModified())
+	assert.True(t, items["changed"].HasNotModified())
+
+	assert.Equal(t, "2000", items["unchanged"].GetLastSyncTimeSinceEpoch())
+	assert.True(t, items["unchanged"].GetNotModified())
+
+	assert.False(t, items["new"].HasLastSyncTimeSinceEpoch())
+	assert.False(t, items["new"].HasNotModified())
+}
+
+func TestFindSourcesReportsStaleSources(t *testing.T) {
+	t.Cleanup(func() { SetStaleSourceThreshold(0) })
+
+	now := time.Now()
+	sources := catalog.NewSourceCollection()
+	sources.Merge("", map[string]catalog.Source{
+		"stale":      {CatalogSource: model.CatalogSource{Id: "stale", Name: "Stale"}, Type: "hf"},
+		"fresh":      {CatalogSource: model.CatalogSource{Id: "fresh", Name: "Fresh"}, Type: "url"},
+		"yaml":       {CatalogSource: model.CatalogSource{Id: "yaml", Name: "YAML"}, Type: "yaml"},
+		"never-sync": {CatalogSource: model.CatalogSource{Id: "never-sync", Name: "Never synced"}, Type: "url"},
+	})
+	repository := &statusSourceRepository{statuses: map[string]dbmodels.SourceStatus{
+		"stale":      {Status: catalog.SourceStatusError, LastSyncTimeSinceEpoch: now.Add(-48 * time.Hour).UnixMilli()},
+		"fresh":      {Status: catalog.SourceStatusAvailable, LastSyncTimeSinceEpoch: now.Add(-10 * time.Minute).UnixMilli()},
+		"yaml":       {Status: catalog.SourceStatusAvailable, LastSyncTimeSinceEpoch: now.Add(-48 * time.Hour).UnixMilli()},
+		"never-sync": {Status: catalog.SourceStatusError, Error: "unreachable"},
+	}}
+	service := NewModelCatalogServiceAPIService(&mockModelProvider{}, sources, catalog.NewLabelCollection(), repository)
+
+	findSources := func() map[string]*model.CatalogSource {
+		t.Helper()
+
+		resp, err := service.FindSources(context.Background(), "", "10", model.ORDERBYFIELD_ID, model.SORTORDER_ASC, "")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		sourceList, ok := resp.Body.(model.CatalogSourceList)
+		require.True(t, ok, "Response body should be a CatalogSourceList")
+		items := map[string]*model.CatalogSource{}
+		for _, item := range sourceList.Items {
+			items[item.Id] = &item
+		}
+		return items
+	}
+
+	// Without a threshold, no source is reported either way.
+	for id, item := range findSources() {
+		assert.False(t, item.HasStale(), "source %s", id)
+	}
+
+	SetStaleSourceThreshold(24 * time.Hour)
+	items := findSources()
+
+	assert.True(t, items["stale"].GetStale())
+	assert.True(t, items["fresh"].HasStale())
+	assert.False(t, items["fresh"].GetStale())
+	assert.False(t, items["yaml"].HasStale(), "sources that do not sync periodically are never stale")
+	assert.False(t, items["never-sync"].HasStale())
+}
@@ -5,10 +5,32 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	model "github.com/kubeflow/model-registry/catalog/pkg/openapi"
 )
 
+const defaultPageSize int32 = 10
+
+// maxPageSize is the largest page size any list endpoint returns, or 0 when
+// page sizes are not limited.
+var maxPageSize atomic.Int32
+
+// SetMaxPageSize sets the largest page size any list endpoint will return.
+// Larger requested page sizes are clamped to this value. Values < 1 disable
+// the limit, which is the default.
+func SetMaxPageSize(size int32) {
+	maxPageSize.Store(max(size, 0))
+}
+
+// clampPageSize limits size to the configured maximum page size, if any.
+func clampPageSize(size int32) int32 {
+	if limit := maxPageSize.Load(); limit > 0 && size > limit {
+		return limit
+	}
+	return size
+}
+
 type paginator[T model.Sortable] struct {
 	PageSize  int32
 	OrderBy   model.OrderByField
@@ -25,7 +47,7 @@ func newPaginator[T model.Sortable](pageSize string, orderBy model.OrderByField,
 	}
 
 	p := &paginator[T]{
-		PageSize:  10, // Default page size
+		PageSize:  defaultPageSize,
 		OrderBy:   orderBy,
 		SortOrder: sortOrder,
 	}
@@ -38,7 +60,7 @@ func newPaginator[T model.Sortable](pageSize string, orderBy model.OrderByField,
 		if pageSize64 < 1 {
 			return nil, fmt.Errorf("pageSize must be at least 1, got %d", pageSize64)
 		}
-		p.PageSize = int32(pageSize64)
+		p.PageSize = clampPageSize(int32(pageSize64))
 	}
 
 	if nextPageToken != "" {
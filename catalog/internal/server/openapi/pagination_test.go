@@ -1,6 +1,7 @@
 package openapi
 
 import (
+	"encoding/json"
 	"strconv"
 	"testing"
 
@@ -224,3 +225,70 @@ func TestPaginateSources_NoDuplicates(t *testing.T) {
 
 	assert.Equal(t, len(allSources), totalSeen, "Total number of items seen should match the original slice")
 }
+
+func TestPaginateSources_EmptyListSerializesAsArray(t *testing.T) {
+	testCases := []struct {
+		name          string
+		items         []model.CatalogSource
+		nextPageToken string
+	}{
+		{
+			name:  "nil input",
+			items: nil,
+		},
+		{
+			name:  "empty input",
+			items: []model.CatalogSource{},
+		},
+		{
+			name:          "cursor past the end",
+			items:         createCatalogSources(3),
+			nextPageToken: (&stringCursor{Value: "source2", ID: "source2"}).String(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			paginator, err := newPaginator[model.CatalogSource]("10", model.ORDERBYFIELD_ID, "", tc.nextPageToken)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			pagedItems, _ := paginator.Paginate(tc.items)
+			assert.NotNil(t, pagedItems)
+
+			body, err := json.Marshal(model.CatalogSourceList{Items: pagedItems})
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Contains(t, string(body), `"items":[]`)
+		})
+	}
+}
+
+func TestNewPaginator_ClampsPageSize(t *testing.T) {
+	t.Cleanup(func() { SetMaxPageSize(0) })
+
+	// Page sizes are not limited by default.
+	paginator, err := newPaginator[model.CatalogSource]("99999", "", "", "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(99999), paginator.PageSize)
+	}
+
+	SetMaxPageSize(50)
+	paginator, err = newPaginator[model.CatalogSource]("100000", "", "", "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(50), paginator.PageSize)
+	}
+
+	paginator, err = newPaginator[model.CatalogSource]("20", "", "", "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(20), paginator.PageSize)
+	}
+
+	SetMaxPageSize(0)
+	paginator, err = newPaginator[model.CatalogSource]("100000", "", "", "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int32(100000), paginator.PageSize)
+	}
+}
@@ -0,0 +1,27 @@
+package openapi
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// staleSourceThreshold is how long after its last sync a periodically synced
+// source is reported as stale, or 0 when sources are never reported stale.
+var staleSourceThreshold atomic.Int64
+
+// SetStaleSourceThreshold sets how long after its last sync a periodically
+// synced source is reported as stale. Values < 1 disable the check, which is
+// the default.
+func SetStaleSourceThreshold(threshold time.Duration) {
+	staleSourceThreshold.Store(int64(max(threshold, 0)))
+}
+
+// isStaleSync reports whether a sync at lastSync is older than the stale
+// source threshold at now. ok is false if no threshold is set.
+func isStaleSync(lastSync, now time.Time) (stale bool, ok bool) {
+	threshold := time.Duration(staleSourceThreshold.Load())
+	if threshold <= 0 {
+		return false, false
+	}
+	return now.Sub(lastSync) > threshold, true
+}
@@ -30,6 +30,12 @@ type CatalogSource struct {
 	Status *CatalogSourceStatus `json:"status,omitempty"`
 	// Detailed error information when the status is \"Error\". This field is null or empty when the source is functioning normally.
 	Error NullableString `json:"error,omitempty"`
+	// Output only. When the source last loaded its models or confirmed that they did not change, in milliseconds since epoch. Unset until the first sync.
+	LastSyncTimeSinceEpoch *string `json:"lastSyncTimeSinceEpoch,omitempty"`
+	// Output only. Whether the last sync found no upstream changes, so no models were loaded.
+	NotModified *bool `json:"notModified,omitempty"`
+	// Output only. Whether the source syncs periodically and its last sync is older than the server's staleness threshold, so its models may be out of date. Unset when the server has no threshold, the source only loads on configuration changes, or it never synced.
+	Stale *bool `json:"stale,omitempty"`
 	// Optional list of glob patterns for models to include. If specified, only models matching at least one pattern will be included. If omitted, all models are considered for inclusion.  Pattern Syntax: - Only the `*` wildcard is supported (matches zero or more characters) - Patterns are case-insensitive (e.g., `Granite/_*` matches `granite/model` and `GRANITE/model`) - Patterns match the entire model name (anchored at start and end) - Wildcards can appear anywhere: `Granite/_*`, `*-beta`, `*deprecated*`, `*_/old*`  Examples: - `ibm-granite/_*` - matches all models starting with \"ibm-granite/\" - `meta-llama/_*` - matches all models in the meta-llama namespace - `*` - matches all models  Constraints: - Patterns cannot be empty or whitespace-only - A pattern cannot appear in both includedModels and excludedModels
 	IncludedModels []string `json:"includedModels,omitempty"`
 	// Optional list of glob patterns for models to exclude. Models matching any pattern will be excluded even if they match an includedModels pattern. Exclusions take precedence over inclusions.  Pattern Syntax: - Only the `*` wildcard is supported (matches zero or more characters) - Patterns are case-insensitive - Patterns match the entire model name (anchored at start and end) - Wildcards can appear anywhere in the pattern  Examples: - `*-draft` - excludes all models ending with \"-draft\" - `*-experimental` - excludes experimental models - `*deprecated*` - excludes models with \"deprecated\" anywhere in the name - `*_/beta-*` - excludes models with \"/beta-\" in the path  Constraints: - Patterns cannot be empty or whitespace-only - A pattern cannot appear in both includedModels and excludedModels
@@ -241,6 +247,102 @@ func (o *CatalogSource) UnsetError() {
 	o.Error.Unset()
 }
 
+// GetLastSyncTimeSinceEpoch returns the LastSyncTimeSinceEpoch field value if set, zero value otherwise.
+func (o *CatalogSource) GetLastSyncTimeSinceEpoch() string {
+	if o == nil || IsNil(o.LastSyncTimeSinceEpoch) {
+		var ret string
+		return ret
+	}
+	return *o.LastSyncTimeSinceEpoch
+}
+
+// GetLastSyncTimeSinceEpochOk returns a tuple with the LastSyncTimeSinceEpoch field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CatalogSource) GetLastSyncTimeSinceEpochOk() (*string, bool) {
+	if o == nil || IsNil(o.LastSyncTimeSinceEpoch) {
+		return nil, false
+	}
+	return o.LastSyncTimeSinceEpoch, true
+}
+
+// HasLastSyncTimeSinceEpoch returns a boolean if a field has been set.
+func (o *CatalogSource) HasLastSyncTimeSinceEpoch() bool {
+	if o != nil && !IsNil(o.LastSyncTimeSinceEpoch) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastSyncTimeSinceEpoch gets a reference to the given string and assigns it to the LastSyncTimeSinceEpoch field.
+func (o *CatalogSource) SetLastSyncTimeSinceEpoch(v string) {
+	o.LastSyncTimeSinceEpoch = &v
+}
+
+// GetNotModified returns the NotModified field value if set, zero value otherwise.
+func (o *CatalogSource) GetNotModified() bool {
+	if o == nil || IsNil(o.NotModified) {
+		var ret bool
+		return ret
+	}
+	return *o.NotModified
+}
+
+// GetNotModifiedOk returns a tuple with the NotModified field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CatalogSource) GetNotModifiedOk() (*bool, bool) {
+	if o == nil || IsNil(o.NotModified) {
+		return nil, false
+	}
+	return o.NotModified, true
+}
+
+// HasNotModified returns a boolean if a field has been set.
+func (o *CatalogSource) HasNotModified() bool {
+	if o != nil && !IsNil(o.NotModified) {
+		return true
+	}
+
+	return false
+}
+
+// SetNotModified gets a reference to the given bool and assigns it to the NotModified field.
+func (o *CatalogSource) SetNotModified(v bool) {
+	o.NotModified = &v
+}
+
+// GetStale returns the Stale field value if set, zero value otherwise.
+func (o *CatalogSource) GetStale() bool {
+	if o == nil || IsNil(o.Stale) {
+		var ret bool
+		return ret
+	}
+	return *o.Stale
+}
+
+// GetStaleOk returns a tuple with the Stale field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CatalogSource) GetStaleOk() (*bool, bool) {
+	if o == nil || IsNil(o.Stale) {
+		return nil, false
+	}
+	return o.Stale, true
+}
+
+// HasStale returns a boolean if a field has been set.
+func (o *CatalogSource) HasStale() bool {
+	if o != nil && !IsNil(o.Stale) {
+		return true
+	}
+
+	return false
+}
+
+// SetStale gets a reference to the given bool and assigns it to the Stale field.
+func (o *CatalogSource) SetStale(v bool) {
+	o.Stale = &v
+}
+
 // GetIncludedModels returns the IncludedModels field value if set, zero value otherwise.
 func (o *CatalogSource) GetIncludedModels() []string {
 	if o == nil || IsNil(o.IncludedModels) {
@@ -327,6 +429,15 @@ func (o CatalogSource) ToMap() (map[string]interface{}, error) {
 	if o.Error.IsSet() {
 		toSerialize["error"] = o.Error.Get()
 	}
+	if !IsNil(o.LastSyncTimeSinceEpoch) {
+		toSerialize["lastSyncTimeSinceEpoch"] = o.LastSyncTimeSinceEpoch
+	}
+	if !IsNil(o.NotModified) {
+		toSerialize["notModified"] = o.NotModified
+	}
+	if !IsNil(o.Stale) {
+		toSerialize["stale"] = o.Stale
+	}
 	if !IsNil(o.IncludedModels) {
 		toSerialize["includedModels"] = o.IncludedModels
 	}
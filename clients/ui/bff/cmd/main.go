@@ -10,6 +10,7 @@ import (
 
 	"github.com/kubeflow/model-registry/ui/bff/internal/api"
 	"github.com/kubeflow/model-registry/ui/bff/internal/config"
+	"github.com/kubeflow/model-registry/ui/bff/internal/repositories"
 
 	"log/slog"
 	"net/http"
@@ -48,6 +49,9 @@ func main() {
 	// TLS configuration flags
 	flag.BoolVar(&cfg.InsecureSkipVerify, "insecure-skip-verify", getEnvAsBool("INSECURE_SKIP_VERIFY", false), "Skip TLS certificate verification (useful for development, default: false)")
 
+	flag.BoolVar(&cfg.StrictSourceConfigDecoding, "strict-source-config-decoding", getEnvAsBool("STRICT_SOURCE_CONFIG_DECODING", false), "Reject catalog source config payloads with unknown fields (default: false)")
+	flag.IntVar(&cfg.MaxInlineCatalogYamlBytes, "max-inline-catalog-yaml-bytes", getEnvAsInt("MAX_INLINE_CATALOG_YAML_BYTES", repositories.DefaultMaxInlineYamlBytes), "Maximum size in bytes of inline YAML content for yaml-type catalog sources")
+
 	// Deprecated flags - kept for backward compatibility
 	flag.BoolVar(&cfg.StandaloneMode, "standalone-mode", false, "DEPRECATED: Use -deployment-mode=standalone instead")
 	flag.BoolVar(&cfg.FederatedPlatform, "federated-platform", false, "DEPRECATED: Use -deployment-mode=federated instead")
@@ -188,11 +188,16 @@ func NewApp(cfg config.EnvConfig, logger *slog.Logger) (*App, error) {
 		return nil, fmt.Errorf("failed to create ModelCatalogSettings client: %w", err)
 	}
 
+	repos := repositories.NewRepositories(mrClient, modelCatalogClient)
+	if cfg.MaxInlineCatalogYamlBytes > 0 {
+		repos.ModelCatalogSettingsRepository.MaxInlineYamlBytes = cfg.MaxInlineCatalogYamlBytes
+	}
+
 	app := &App{
 		config:                  cfg,
 		logger:                  logger,
 		kubernetesClientFactory: k8sFactory,
-		repositories:            repositories.NewRepositories(mrClient, modelCatalogClient),
+		repositories:            repos,
 		testEnv:                 testEnv,
 		rootCAs:                 rootCAs,
 	}
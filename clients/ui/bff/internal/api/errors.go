@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/kubeflow/model-registry/ui/bff/internal/constants"
 	"github.com/kubeflow/model-registry/ui/bff/internal/integrations/httpclient"
 )
 
@@ -59,6 +60,11 @@ func (app *App) forbiddenResponse(w http.ResponseWriter, r *http.Request, messag
 
 func (app *App) errorResponse(w http.ResponseWriter, r *http.Request, error *httpclient.HTTPError) {
 
+	// Include the trace id in the body so failures can be correlated with the server logs.
+	if traceId, ok := r.Context().Value(constants.TraceIdKey).(string); ok {
+		error.RequestId = traceId
+	}
+
 	env := ErrorEnvelope{Error: error}
 
 	err := app.WriteJSON(w, error.StatusCode, env, nil)
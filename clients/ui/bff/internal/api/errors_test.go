@@ -0,0 +1,42 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kubeflow/model-registry/ui/bff/internal/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorResponseIncludesRequestId(t *testing.T) {
+	app := &App{}
+
+	var traceId string
+	handler := app.EnableTelemetry(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceId, _ = r.Context().Value(constants.TraceIdKey).(string)
+		app.notFoundResponse(w, r)
+	}))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/v1/model_registry", nil))
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+
+	var envelope ErrorEnvelope
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &envelope))
+	require.NotNil(t, envelope.Error)
+	assert.NotEmpty(t, traceId)
+	assert.Equal(t, traceId, envelope.Error.RequestId)
+}
+
+func TestErrorResponseWithoutRequestId(t *testing.T) {
+	app := &App{}
+
+	rr := httptest.NewRecorder()
+	app.notFoundResponse(rr, httptest.NewRequest(http.MethodGet, "/api/v1/model_registry", nil))
+
+	assert.NotContains(t, rr.Body.String(), "requestId")
+}
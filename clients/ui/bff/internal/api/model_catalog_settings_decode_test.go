@@ -0,0 +1,48 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/model-registry/ui/bff/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const typoCatalogSourcePayload = `{"data": {"id": "custom", "name": "Custom", "type": "yaml", "enabeld": true}}`
+
+func TestReadCatalogSourcePayloadStrictRejectsUnknownField(t *testing.T) {
+	app := &App{config: config.EnvConfig{StrictSourceConfigDecoding: true}}
+
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, ModelCatalogSettingsSourceConfigListPath, strings.NewReader(typoCatalogSourcePayload))
+
+	var envelope ModelCatalogSourcePayloadEnvelope
+	ok := app.readCatalogSourcePayload(rr, req, &envelope)
+
+	assert.False(t, ok)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	var errEnvelope ErrorEnvelope
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &errEnvelope))
+	require.NotNil(t, errEnvelope.Error)
+	assert.Contains(t, errEnvelope.Error.Message, `"enabeld"`)
+}
+
+func TestReadCatalogSourcePayloadLenientIgnoresUnknownField(t *testing.T) {
+	app := &App{}
+
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, ModelCatalogSettingsSourceConfigListPath, strings.NewReader(typoCatalogSourcePayload))
+
+	var envelope ModelCatalogSourcePayloadEnvelope
+	ok := app.readCatalogSourcePayload(rr, req, &envelope)
+
+	assert.True(t, ok)
+	require.NotNil(t, envelope.Data)
+	assert.Equal(t, "custom", envelope.Data.Id)
+	assert.Nil(t, envelope.Data.Enabled)
+}
@@ -104,8 +104,7 @@ func (app *App) CreateCatalogSourceConfigHandler(w http.ResponseWriter, r *http.
 	}
 
 	var envelope ModelCatalogSourcePayloadEnvelope
-	if err := json.NewDecoder(r.Body).Decode(&envelope); err != nil {
-		app.serverErrorResponse(w, r, fmt.Errorf("error decoding JSON: %v", err.Error()))
+	if !app.readCatalogSourcePayload(w, r, &envelope) {
 		return
 	}
 
@@ -154,8 +153,7 @@ func (app *App) UpdateCatalogSourceConfigHandler(w http.ResponseWriter, r *http.
 	}
 
 	var envelope ModelCatalogSourcePayloadEnvelope
-	if err := json.NewDecoder(r.Body).Decode(&envelope); err != nil {
-		app.serverErrorResponse(w, r, fmt.Errorf("error decoding JSON: %v", err.Error()))
+	if !app.readCatalogSourcePayload(w, r, &envelope) {
 		return
 	}
 
@@ -168,6 +166,8 @@ func (app *App) UpdateCatalogSourceConfigHandler(w http.ResponseWriter, r *http.
 	if err != nil {
 		if errors.Is(err, repositories.ErrCatalogSourceNotFound) {
 			app.notFoundResponse(w, r)
+		} else if errors.Is(err, repositories.ErrValidationFailed) {
+			app.badRequestResponse(w, r, err)
 		} else if errors.Is(err, repositories.ErrCannotChangeDefaultSource) ||
 			errors.Is(err, repositories.ErrCannotChangeType) {
 			app.forbiddenResponse(w, r, err.Error())
@@ -232,3 +232,22 @@ func (app *App) DeleteCatalogSourceConfigHandler(w http.ResponseWriter, r *http.
 		app.serverErrorResponse(w, r, err)
 	}
 }
+
+// readCatalogSourcePayload decodes a catalog source config payload from the request body,
+// writing an error response and returning false on failure. Unknown fields are rejected
+// with 400 Bad Request when strict decoding is enabled.
+func (app *App) readCatalogSourcePayload(w http.ResponseWriter, r *http.Request, envelope *ModelCatalogSourcePayloadEnvelope) bool {
+	if app.config.StrictSourceConfigDecoding {
+		if err := app.ReadJSON(w, r, envelope); err != nil {
+			app.badRequestResponse(w, r, err)
+			return false
+		}
+		return true
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(envelope); err != nil {
+		app.serverErrorResponse(w, r, fmt.Errorf("error decoding JSON: %v", err.Error()))
+		return false
+	}
+	return true
+}
@@ -109,6 +109,15 @@ type EnvConfig struct {
 	// Default is false (secure) for production environments
 	InsecureSkipVerify bool
 
+	// StrictSourceConfigDecoding when true, rejects catalog source config payloads
+	// containing unknown fields with 400 Bad Request instead of ignoring them.
+	// Default is false for compatibility with existing clients.
+	StrictSourceConfigDecoding bool
+
+	// MaxInlineCatalogYamlBytes limits the inline YAML content accepted for
+	// yaml-type catalog sources, which is stored in a ConfigMap.
+	MaxInlineCatalogYamlBytes int
+
 	// ─── DEPRECATED ─────────────────────────────────────────────
 	// The following fields are deprecated and maintained for backward compatibility
 	// Use DeploymentMode instead
@@ -29,8 +29,9 @@ type HTTPClient struct {
 }
 
 type ErrorResponse struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	RequestId string `json:"requestId,omitempty"`
 }
 
 type HTTPError struct {
@@ -24,7 +24,7 @@ func NewModelCatalogClientMock(logger *slog.Logger) (*ModelCatalogClientMock, er
 
 func (m *ModelCatalogClientMock) GetAllCatalogModelsAcrossSources(client httpclient.HTTPClientInterface, pageValues url.Values) (*models.CatalogModelList, error) {
 	allModels := GetCatalogModelMocks()
-	var filteredModels []models.CatalogModel
+	filteredModels := []models.CatalogModel{}
 
 	sourceId := pageValues.Get("source")
 	sourceLabel := pageValues.Get("sourceLabel")
@@ -72,7 +72,7 @@ func (m *ModelCatalogClientMock) GetAllCatalogModelsAcrossSources(client httpcli
 	}
 
 	if query != "" {
-		var queryFilteredModels []models.CatalogModel
+		queryFilteredModels := []models.CatalogModel{}
 		queryLower := strings.ToLower(query)
 
 		for _, model := range filteredModels {
@@ -176,7 +176,7 @@ func (m *ModelCatalogClientMock) GetCatalogSourceModel(client httpclient.HTTPCli
 
 func (m *ModelCatalogClientMock) GetAllCatalogSources(client httpclient.HTTPClientInterface, pageValues url.Values) (*models.CatalogSourceList, error) {
 	allMockSources := GetCatalogSourceListMock()
-	var filteredMockSources []models.CatalogSource
+	filteredMockSources := []models.CatalogSource{}
 
 	name := pageValues.Get("name")
 
@@ -0,0 +1,53 @@
+package mocks
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAllCatalogModelsAcrossSourcesEmptyListSerializesAsArray(t *testing.T) {
+	testCases := []struct {
+		name       string
+		pageValues url.Values
+	}{
+		{
+			name:       "unknown source",
+			pageValues: url.Values{"source": {"no-such-source"}},
+		},
+		{
+			name:       "unknown source label",
+			pageValues: url.Values{"sourceLabel": {"no-such-label"}},
+		},
+		{
+			name:       "query matching nothing",
+			pageValues: url.Values{"q": {"no-such-model"}},
+		},
+	}
+
+	client := &ModelCatalogClientMock{}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, err := client.GetAllCatalogModelsAcrossSources(nil, tc.pageValues)
+			require.NoError(t, err)
+
+			body, err := json.Marshal(list)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), `"items":[]`)
+		})
+	}
+}
+
+func TestGetAllCatalogSourcesEmptyListSerializesAsArray(t *testing.T) {
+	client := &ModelCatalogClientMock{}
+
+	list, err := client.GetAllCatalogSources(nil, url.Values{"name": {"no-such-source"}})
+	require.NoError(t, err)
+
+	body, err := json.Marshal(list)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"items":[]`)
+}
@@ -2226,7 +2226,7 @@ func CreateCatalogSourcePreviewMockWithFilter(filterStatus string, pageSize int,
 	catalogSourcePreviewSummary := GetCatalogSourcePreviewSummaryMock()
 
 	// Filter based on filterStatus
-	var filteredModels []models.CatalogSourcePreviewModel
+	filteredModels := []models.CatalogSourcePreviewModel{}
 	switch filterStatus {
 	case "included":
 		for _, m := range allModels {
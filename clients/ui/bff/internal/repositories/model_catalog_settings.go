@@ -16,11 +16,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DefaultMaxInlineYamlBytes is the default limit on the inline YAML content of a
+// yaml-type source. The content is stored in the user managed ConfigMap, which
+// Kubernetes limits to 1MiB in total.
+const DefaultMaxInlineYamlBytes = 512 * 1024
+
 type ModelCatalogSettingsRepository struct {
+	// MaxInlineYamlBytes limits the size of the inline YAML content accepted for
+	// yaml-type sources. Zero or less means DefaultMaxInlineYamlBytes.
+	MaxInlineYamlBytes int
 }
 
 func NewModelCatalogSettingsRepository() *ModelCatalogSettingsRepository {
-	return &ModelCatalogSettingsRepository{}
+	return &ModelCatalogSettingsRepository{MaxInlineYamlBytes: DefaultMaxInlineYamlBytes}
 }
 
 var (
@@ -152,6 +160,12 @@ func (r *ModelCatalogSettingsRepository) CreateCatalogSourceConfig(
 		return nil, err
 	}
 
+	if payload.Yaml != nil {
+		if err := r.validateInlineYamlSize(*payload.Yaml); err != nil {
+			return nil, err
+		}
+	}
+
 	defaultCM, userCM, err := client.GetAllCatalogSourceConfigs(ctx, namespace)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch catalog source configmaps: %w", err)
@@ -287,6 +301,9 @@ func (r *ModelCatalogSettingsRepository) UpdateCatalogSourceConfig(
 		switch catalogType {
 		case CatalogTypeYaml:
 			if payload.Yaml != nil && *payload.Yaml != "" {
+				if err := r.validateInlineYamlSize(*payload.Yaml); err != nil {
+					return nil, err
+				}
 				if yamlFilePath == "" {
 					yamlFilePath = fmt.Sprintf("%s.yaml", sourceId)
 				}
@@ -447,6 +464,21 @@ func mergeCatalogSourceConfigs(defaultCatalog models.CatalogSourceConfig, userCa
 	return mergedSource
 }
 
+// validateInlineYamlSize rejects inline YAML content that is too large to be
+// stored safely in the user managed ConfigMap.
+func (r *ModelCatalogSettingsRepository) validateInlineYamlSize(content string) error {
+	limit := r.MaxInlineYamlBytes
+	if limit <= 0 {
+		limit = DefaultMaxInlineYamlBytes
+	}
+
+	if len(content) > limit {
+		return fmt.Errorf("%w: yaml content is %d bytes, exceeding the %d byte limit; host the catalog file outside the source configuration instead",
+			ErrValidationFailed, len(content), limit)
+	}
+	return nil
+}
+
 func validateCatalogSourceConfigPayload(payload models.CatalogSourceConfigPayload) error {
 	if payload.Id == "" {
 		return fmt.Errorf("%w", ErrCatalogSourceIdRequired)
@@ -109,6 +109,35 @@ var _ = Describe("ModelCatalogSettingRepository", func() {
 	})
 
 	Describe("CreateCatalogSourceConfig", func() {
+		It("should accept yaml content up to the inline size limit", func() {
+			repo.MaxInlineYamlBytes = 64
+			content := "models: []\n" + strings.Repeat("#", 64-len("models: []\n"))
+			payload := models.CatalogSourceConfigPayload{
+				Id:      "inline_size_limit",
+				Name:    "Inline Size Limit",
+				Type:    "yaml",
+				Enabled: boolPtr(true),
+				Yaml:    stringPtr(content),
+			}
+			result, err := repo.CreateCatalogSourceConfig(ctx, k8sClient, "kubeflow", payload)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Id).To(Equal("inline_size_limit"))
+		})
+
+		It("should fail when yaml content exceeds the inline size limit", func() {
+			repo.MaxInlineYamlBytes = 64
+			payload := models.CatalogSourceConfigPayload{
+				Id:      "inline_too_large",
+				Name:    "Inline Too Large",
+				Type:    "yaml",
+				Enabled: boolPtr(true),
+				Yaml:    stringPtr("models: []\n" + strings.Repeat("#", 64)),
+			}
+			_, err := repo.CreateCatalogSourceConfig(ctx, k8sClient, "kubeflow", payload)
+			Expect(err).To(MatchError(ErrValidationFailed))
+			Expect(err.Error()).To(ContainSubstring("exceeding the 64 byte limit"))
+		})
+
 		It("should fail when id is missing", func() {
 			payload := models.CatalogSourceConfigPayload{
 				Name:    "Test",
@@ -323,6 +352,15 @@ var _ = Describe("ModelCatalogSettingRepository", func() {
 	})
 
 	Describe("UpdateCatalogSourceConfig", func() {
+		It("should fail when updated yaml content exceeds the inline size limit", func() {
+			repo.MaxInlineYamlBytes = 64
+			payload := models.CatalogSourceConfigPayload{
+				Yaml: stringPtr("models: []\n" + strings.Repeat("#", 64)),
+			}
+			_, err := repo.UpdateCatalogSourceConfig(ctx, k8sClient, "kubeflow", "custom_yaml_models", payload)
+			Expect(err).To(MatchError(ErrValidationFailed))
+		})
+
 		It("should fail if source does not exist", func() {
 			payload := models.CatalogSourceConfigPayload{
 				Enabled: boolPtr(false),
@@ -144,7 +144,7 @@ func (m *ModelRegistryRepository) GetAllModelTransferJobs(ctx context.Context, c
 		return nil, fmt.Errorf("failed to fetch model transfer jobs: %w", err)
 	}
 
-	var transferJobs []models.ModelTransferJob
+	transferJobs := make([]models.ModelTransferJob, 0, len(jobList.Items))
 	for _, job := range jobList.Items {
 		transferJobs = append(transferJobs, convertK8sJobToModel(&job))
 	}
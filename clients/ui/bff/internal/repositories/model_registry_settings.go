@@ -21,7 +21,7 @@ func (r *ModelRegistrySettingsRepository) GetGroups(ctx context.Context, client
 		return nil, fmt.Errorf("error fetching groups: %w", err)
 	}
 
-	var groups []models.Group
+	groups := make([]models.Group, 0, len(groupNames))
 	for _, name := range groupNames {
 		// Create mock users for each group to make the data more realistic
 		var users []string
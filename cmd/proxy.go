@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/kubeflow/model-registry/internal/core"
@@ -24,6 +25,7 @@ import (
 type ProxyConfig struct {
 	EmbedMD       embedmd.EmbedMDConfig
 	DatastoreType string
+	Backpressure  middleware.BackpressureConfig
 }
 
 const (
@@ -92,6 +94,10 @@ func (c *ConditionalModelRegistryHealthChecker) Check() proxy.HealthCheck {
 }
 
 func runProxyServer(cmd *cobra.Command, args []string) error {
+	if err := proxyCfg.Backpressure.Validate(); err != nil {
+		return fmt.Errorf("invalid --db-backpressure-* flags: %w", err)
+	}
+
 	var (
 		ds datastore.Connector
 		wg sync.WaitGroup
@@ -167,10 +173,18 @@ func runProxyServer(cmd *cobra.Command, args []string) error {
 			return
 		}
 
+		if maxConns := proxyCfg.Backpressure.MaxConnections; maxConns > 0 {
+			if err := middleware.LimitDBPool(maxConns); err != nil {
+				errChan <- fmt.Errorf("error limiting database connections: %w", err)
+				return
+			}
+			glog.Infof("Database connection pool limited to %d connections", maxConns)
+		}
+
 		ModelRegistryServiceAPIService := openapi.NewModelRegistryServiceAPIService(conn)
 		ModelRegistryServiceAPIController := openapi.NewModelRegistryServiceAPIController(ModelRegistryServiceAPIService)
 
-		router.SetRouter(middleware.WrapWithValidation(ModelRegistryServiceAPIController))
+		router.SetRouter(middleware.BackpressureMiddleware(middleware.WrapWithValidation(ModelRegistryServiceAPIController), proxyCfg.Backpressure))
 
 		// Set the model registry service in the holder for health checks AFTER router is ready
 		// This ensures the readiness probe only passes when the router can serve actual requests
@@ -249,4 +263,8 @@ func init() {
 	proxyCmd.Flags().BoolVar(&proxyCfg.EmbedMD.TLSConfig.VerifyServerCert, "embedmd-database-ssl-verify-server-cert", false, "EmbedMD SSL verify server cert")
 
 	proxyCmd.Flags().StringVar(&proxyCfg.DatastoreType, "datastore-type", proxyCfg.DatastoreType, "Datastore type")
+
+	proxyCmd.Flags().Float64Var(&proxyCfg.Backpressure.Threshold, "db-backpressure-threshold", 0, "Fraction (0-1] of database connections in use above which new mutating requests are rejected with 503; 0 disables backpressure")
+	proxyCmd.Flags().IntVar(&proxyCfg.Backpressure.MaxConnections, "db-backpressure-max-conns", 0, "Maximum number of open database connections, required by --db-backpressure-threshold; 0 leaves the pool unbounded")
+	proxyCmd.Flags().DurationVar(&proxyCfg.Backpressure.RetryAfter, "db-backpressure-retry-after", time.Second, "Retry-After value returned when rejecting requests due to database backpressure")
 }
@@ -0,0 +1,168 @@
+package middleware
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/kubeflow/model-registry/internal/db"
+)
+
+// defaultRetryAfter is the Retry-After value sent when the pool is saturated
+// and no explicit value was configured.
+const defaultRetryAfter = time.Second
+
+// DBStatsFunc returns the current statistics of the database connection pool.
+// The boolean result is false when no pool is available yet.
+type DBStatsFunc func() (sql.DBStats, bool)
+
+// BackpressureConfig configures BackpressureMiddleware.
+type BackpressureConfig struct {
+	// Threshold is the fraction (0 < Threshold <= 1) of the maximum number of
+	// connections that may be in use before new mutating requests are
+	// rejected. A value <= 0 disables backpressure.
+	Threshold float64
+
+	// MaxConnections is the maximum number of open database connections. It
+	// must be set when Threshold is, and the server bounds the pool to it with
+	// LimitDBPool. It is only compared against directly while the pool is
+	// still unbounded (sql.DBStats.MaxOpenConnections == 0).
+	MaxConnections int
+
+	// RetryAfter is the value sent in the Retry-After header. Defaults to one
+	// second.
+	RetryAfter time.Duration
+
+	// Stats returns the pool statistics. Defaults to DBPoolStats.
+	Stats DBStatsFunc
+}
+
+// Validate checks that an enabled backpressure has a connection limit to
+// compare against. database/sql pools are unbounded by default, so a threshold
+// without MaxConnections would never reject any request.
+func (c BackpressureConfig) Validate() error {
+	if c.MaxConnections < 0 {
+		return errors.New("max connections must not be negative")
+	}
+	if c.Threshold > 0 && c.MaxConnections == 0 {
+		return errors.New("a threshold requires max connections to bound the database connection pool")
+	}
+	return nil
+}
+
+// LimitDBPool bounds the connection pool backing the global database connector
+// to maxConns open connections.
+func LimitDBPool(maxConns int) error {
+	connector, ok := db.GetConnector()
+	if !ok || connector.DB() == nil {
+		return errors.New("database connector not initialized")
+	}
+
+	sqlDB, err := connector.DB().DB()
+	if err != nil {
+		return fmt.Errorf("unable to get database connection pool: %w", err)
+	}
+
+	sqlDB.SetMaxOpenConns(maxConns)
+	return nil
+}
+
+// DBPoolStats returns the statistics of the connection pool backing the
+// global database connector.
+func DBPoolStats() (sql.DBStats, bool) {
+	connector, ok := db.GetConnector()
+	if !ok || connector.DB() == nil {
+		return sql.DBStats{}, false
+	}
+
+	sqlDB, err := connector.DB().DB()
+	if err != nil {
+		return sql.DBStats{}, false
+	}
+
+	return sqlDB.Stats(), true
+}
+
+// BackpressureMiddleware rejects new mutating requests with 503 Service
+// Unavailable and a Retry-After header when the number of in-use database
+// connections is at or above the configured threshold, instead of letting them
+// queue on the pool indefinitely. Read requests are always let through.
+func BackpressureMiddleware(next http.Handler, cfg BackpressureConfig) http.Handler {
+	if cfg.Threshold <= 0 {
+		return next
+	}
+	if cfg.Threshold > 1 {
+		cfg.Threshold = 1
+	}
+	if cfg.RetryAfter <= 0 {
+		cfg.RetryAfter = defaultRetryAfter
+	}
+	if cfg.Stats == nil {
+		cfg.Stats = DBPoolStats
+	}
+	retryAfter := strconv.Itoa(int(math.Ceil(cfg.RetryAfter.Seconds())))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isMutatingMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		stats, ok := cfg.Stats()
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		maxConns := stats.MaxOpenConnections
+		if maxConns <= 0 {
+			maxConns = cfg.MaxConnections
+		}
+		if maxConns <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		limit := int(math.Ceil(cfg.Threshold * float64(maxConns)))
+		if stats.InUse < limit {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		glog.Warningf("Database connection pool saturated (%d/%d in use), rejecting %s %s", stats.InUse, maxConns, r.Method, r.URL.Path)
+		returnServiceUnavailable(w, retryAfter, "Database connection pool is saturated, please retry later")
+	})
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// returnServiceUnavailable sends a standardized 503 Service Unavailable response
+func returnServiceUnavailable(w http.ResponseWriter, retryAfter string, message string) {
+	errorResponse := struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}{
+		Code:    "Service Unavailable",
+		Message: message,
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.Header().Set("Retry-After", retryAfter)
+	w.WriteHeader(http.StatusServiceUnavailable)
+	if err := json.NewEncoder(w).Encode(errorResponse); err != nil {
+		glog.Errorf("Error encoding JSON error response: %v", err)
+	}
+}
@@ -0,0 +1,187 @@
+package middleware
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kubeflow/model-registry/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestBackpressureMiddleware(t *testing.T) {
+	okHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	statsFunc := func(inUse, maxOpen int) DBStatsFunc {
+		return func() (sql.DBStats, bool) {
+			return sql.DBStats{InUse: inUse, MaxOpenConnections: maxOpen}, true
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		method         string
+		cfg            BackpressureConfig
+		expectedStatus int
+	}{
+		{
+			name:           "saturated pool rejects mutating request",
+			method:         http.MethodPost,
+			cfg:            BackpressureConfig{Threshold: 0.9, Stats: statsFunc(10, 10)},
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "pool at threshold rejects mutating request",
+			method:         http.MethodPatch,
+			cfg:            BackpressureConfig{Threshold: 0.8, Stats: statsFunc(8, 10)},
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "pool below threshold accepts mutating request",
+			method:         http.MethodPost,
+			cfg:            BackpressureConfig{Threshold: 0.8, Stats: statsFunc(7, 10)},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "saturated pool still serves reads",
+			method:         http.MethodGet,
+			cfg:            BackpressureConfig{Threshold: 0.9, Stats: statsFunc(10, 10)},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unbounded pool uses configured max connections",
+			method:         http.MethodDelete,
+			cfg:            BackpressureConfig{Threshold: 0.5, MaxConnections: 4, Stats: statsFunc(2, 0)},
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "unbounded pool without max connections is never rejected",
+			method:         http.MethodPost,
+			cfg:            BackpressureConfig{Threshold: 0.5, Stats: statsFunc(100, 0)},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "zero threshold disables backpressure",
+			method:         http.MethodPost,
+			cfg:            BackpressureConfig{Stats: statsFunc(10, 10)},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "missing pool is never rejected",
+			method: http.MethodPost,
+			cfg: BackpressureConfig{Threshold: 0.5, Stats: func() (sql.DBStats, bool) {
+				return sql.DBStats{}, false
+			}},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := BackpressureMiddleware(okHandler, tc.cfg)
+
+			req := httptest.NewRequest(tc.method, "/api/model_registry/v1alpha3/registered_models", nil)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+			if tc.expectedStatus == http.StatusServiceUnavailable {
+				assert.Equal(t, "1", rr.Header().Get("Retry-After"))
+				assert.Contains(t, rr.Body.String(), "saturated")
+			} else {
+				assert.Empty(t, rr.Header().Get("Retry-After"))
+			}
+		})
+	}
+}
+
+func TestBackpressureMiddlewareRetryAfter(t *testing.T) {
+	handler := BackpressureMiddleware(http.NotFoundHandler(), BackpressureConfig{
+		Threshold:  1,
+		RetryAfter: 2500 * time.Millisecond,
+		Stats: func() (sql.DBStats, bool) {
+			return sql.DBStats{InUse: 5, MaxOpenConnections: 5}, true
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodPut, "/test", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+	assert.Equal(t, "3", rr.Header().Get("Retry-After"))
+}
+
+func TestBackpressureConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cfg     BackpressureConfig
+		wantErr bool
+	}{
+		{
+			name: "disabled",
+			cfg:  BackpressureConfig{},
+		},
+		{
+			name: "max connections without threshold",
+			cfg:  BackpressureConfig{MaxConnections: 10},
+		},
+		{
+			name: "threshold with max connections",
+			cfg:  BackpressureConfig{Threshold: 0.8, MaxConnections: 10},
+		},
+		{
+			name:    "threshold without max connections",
+			cfg:     BackpressureConfig{Threshold: 0.8},
+			wantErr: true,
+		},
+		{
+			name:    "negative max connections",
+			cfg:     BackpressureConfig{MaxConnections: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLimitDBPool(t *testing.T) {
+	t.Cleanup(db.ClearConnector)
+
+	db.ClearConnector()
+	assert.Error(t, LimitDBPool(4))
+
+	mockDB, _, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = mockDB.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: mockDB}), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	db.SetDB(gormDB)
+
+	require.NoError(t, LimitDBPool(4))
+
+	stats, ok := DBPoolStats()
+	require.True(t, ok)
+	assert.Equal(t, 4, stats.MaxOpenConnections)
+}